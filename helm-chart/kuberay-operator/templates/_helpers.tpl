@@ -143,6 +143,15 @@ rules:
   - get
   - list
   - watch
+- apiGroups:
+  - ""
+  resources:
+  - persistentvolumeclaims
+  verbs:
+  - create
+  - get
+  - list
+  - watch
 - apiGroups:
   - ""
   resources:
@@ -170,9 +179,12 @@ rules:
   resources:
   - secrets
   verbs:
+  - create
   - delete
   - get
   - list
+  - patch
+  - update
   - watch
 - apiGroups:
   - ""
@@ -238,6 +250,14 @@ rules:
   - get
   - patch
   - update
+- apiGroups:
+  - cert-manager.io
+  resources:
+  - clusterissuers
+  verbs:
+  - get
+  - list
+  - watch
 - apiGroups:
   - config.openshift.io
   resources:
@@ -258,6 +278,14 @@ rules:
   - get
   - list
   - update
+- apiGroups:
+  - discovery.k8s.io
+  resources:
+  - endpointslices
+  verbs:
+  - get
+  - list
+  - watch
 - apiGroups:
   - extensions
   resources:
@@ -320,6 +348,18 @@ rules:
   - get
   - list
   - watch
+- apiGroups:
+  - policy
+  resources:
+  - poddisruptionbudgets
+  verbs:
+  - create
+  - delete
+  - get
+  - list
+  - patch
+  - update
+  - watch
 - apiGroups:
   - ray.io
   resources:
@@ -334,6 +374,12 @@ rules:
   - patch
   - update
   - watch
+- apiGroups:
+  - ray.io
+  resources:
+  - rayclusters/dashboard
+  verbs:
+  - get
 - apiGroups:
   - ray.io
   resources:
@@ -365,15 +411,6 @@ rules:
   - rbac.authorization.k8s.io
   resources:
   - rolebindings
-  verbs:
-  - create
-  - delete
-  - get
-  - list
-  - watch
-- apiGroups:
-  - rbac.authorization.k8s.io
-  resources:
   - roles
   verbs:
   - create
@@ -394,7 +431,7 @@ rules:
   - patch
   - update
   - watch
-{{- if or .batchSchedulerEnabled (eq .batchSchedulerName "volcano") }}
+{{- if or .batchSchedulerEnabled (eq .batchSchedulerName "volcano") (has "volcano" .batchSchedulerNames) }}
 - apiGroups:
   - scheduling.volcano.sh
   resources:
@@ -413,7 +450,7 @@ rules:
   verbs:
   - get
 {{- end -}}
-{{- if or .batchSchedulerEnabled (eq .batchSchedulerName "scheduler-plugins") }}
+{{- if or .batchSchedulerEnabled (eq .batchSchedulerName "scheduler-plugins") (has "scheduler-plugins" .batchSchedulerNames) }}
 - apiGroups:
   - scheduling.x-k8s.io
   resources:
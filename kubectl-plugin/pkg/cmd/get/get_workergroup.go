@@ -31,6 +31,8 @@ type GetWorkerGroupsOptions struct {
 }
 
 type enrichedWorkerGroupSpec struct {
+	// status is nil when the RayCluster status doesn't report the worker group, e.g. for older KubeRay operators.
+	status    *rayv1.WorkerGroupStatus
 	namespace string
 	cluster   string
 	spec      rayv1.WorkerGroupSpec
@@ -156,6 +158,7 @@ func (options *GetWorkerGroupsOptions) Run(ctx context.Context, k8sClient client
 					namespace: rayCluster.Namespace,
 					cluster:   rayCluster.Name,
 					spec:      spec,
+					status:    findWorkerGroupStatus(rayCluster.Status, spec.GroupName),
 				})
 			}
 		} else {
@@ -165,6 +168,7 @@ func (options *GetWorkerGroupsOptions) Run(ctx context.Context, k8sClient client
 						namespace: rayCluster.Namespace,
 						cluster:   rayCluster.Name,
 						spec:      spec,
+						status:    findWorkerGroupStatus(rayCluster.Status, spec.GroupName),
 					})
 				}
 			}
@@ -202,24 +206,42 @@ func errorMessageForWorkerGroupNotFound(workerGroup, cluster, namespace string,
 	return errMsg
 }
 
-// getWorkerGroupDetails takes an array of enrichedWorkerGroupSpecs, gets the corresponding K8s Pod, and returns an array of worker groups
+// findWorkerGroupStatus returns the status of the worker group reported by the RayCluster, or nil if there is none
+func findWorkerGroupStatus(status rayv1.RayClusterStatus, groupName string) *rayv1.WorkerGroupStatus {
+	for i := range status.WorkerGroupStatuses {
+		if status.WorkerGroupStatuses[i].GroupName == groupName {
+			return &status.WorkerGroupStatuses[i]
+		}
+	}
+	return nil
+}
+
+// getWorkerGroupDetails takes an array of enrichedWorkerGroupSpecs, gets the corresponding K8s Pod, and returns an array of worker groups.
+// The replica counts are taken from the worker group status reported by the RayCluster if there is one.
 func getWorkerGroupDetails(ctx context.Context, enrichedWorkerGroupSpecs []enrichedWorkerGroupSpec, k8sClient client.Client) ([]workerGroup, error) {
 	var workerGroups []workerGroup
 
 	for _, ewgs := range enrichedWorkerGroupSpecs {
-		selectors, err := createRayWorkerGroupLabelSelectors(ewgs.spec.GroupName, ewgs.cluster)
-		if err != nil {
-			return nil, fmt.Errorf("could not create K8s label selectors for a worker group in cluster %s, namespace %s: %w", ewgs.cluster, ewgs.namespace, err)
-		}
+		var readyWorkerReplicas, desiredWorkerReplicas int32
+		if ewgs.status != nil {
+			readyWorkerReplicas = ewgs.status.ReadyReplicas
+			desiredWorkerReplicas = ewgs.status.DesiredReplicas
+		} else {
+			selectors, err := createRayWorkerGroupLabelSelectors(ewgs.spec.GroupName, ewgs.cluster)
+			if err != nil {
+				return nil, fmt.Errorf("could not create K8s label selectors for a worker group in cluster %s, namespace %s: %w", ewgs.cluster, ewgs.namespace, err)
+			}
 
-		podList, err := k8sClient.KubernetesClient().CoreV1().Pods(ewgs.namespace).List(ctx, v1.ListOptions{
-			LabelSelector: joinLabelMap(selectors),
-		})
-		if err != nil {
-			return nil, err
-		}
+			podList, err := k8sClient.KubernetesClient().CoreV1().Pods(ewgs.namespace).List(ctx, v1.ListOptions{
+				LabelSelector: joinLabelMap(selectors),
+			})
+			if err != nil {
+				return nil, err
+			}
 
-		readyWorkerReplicas := calculateReadyReplicas(*podList)
+			readyWorkerReplicas = calculateReadyReplicas(*podList)
+			desiredWorkerReplicas = *ewgs.spec.Replicas
+		}
 
 		workerGroupResources := calculateDesiredResourcesForWorkerGroup(ewgs.spec)
 
@@ -227,7 +249,7 @@ func getWorkerGroupDetails(ctx context.Context, enrichedWorkerGroupSpecs []enric
 			namespace:       ewgs.namespace,
 			name:            ewgs.spec.GroupName,
 			readyReplicas:   readyWorkerReplicas,
-			desiredReplicas: *ewgs.spec.Replicas,
+			desiredReplicas: desiredWorkerReplicas,
 			totalCPU:        *workerGroupResources.Cpu(),
 			totalGPU:        workerGroupResources[corev1.ResourceName(util.ResourceNvidiaGPU)],
 			totalTPU:        workerGroupResources[corev1.ResourceName(util.ResourceGoogleTPU)],
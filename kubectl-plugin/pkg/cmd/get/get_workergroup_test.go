@@ -655,6 +655,42 @@ func TestGetWorkerGroupDetails(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "should use the worker group status reported by the RayCluster",
+			enrichedWorkerGroupSpecs: []enrichedWorkerGroupSpec{
+				{
+					namespace: "namespace-1",
+					cluster:   "cluster-1",
+					spec: rayv1.WorkerGroupSpec{
+						GroupName:  "group-1",
+						Replicas:   ptr.To(int32(1)),
+						NumOfHosts: 2,
+						Template:   podTemplate,
+					},
+					status: &rayv1.WorkerGroupStatus{
+						GroupName:       "group-1",
+						ReadyReplicas:   1,
+						DesiredReplicas: 2,
+						PendingReplicas: 1,
+					},
+				},
+			},
+			// The Pods must not be listed when the status is reported.
+			listPodsError: "DEADBEEF",
+			expectedWorkerGroups: []workerGroup{
+				{
+					namespace:       "namespace-1",
+					cluster:         "cluster-1",
+					name:            "group-1",
+					readyReplicas:   1,
+					desiredReplicas: 2,
+					totalCPU:        *resources.Cpu(),
+					totalGPU:        *resources.Name(util.ResourceNvidiaGPU, resource.DecimalSI),
+					totalTPU:        *resources.Name(util.ResourceGoogleTPU, resource.DecimalSI),
+					totalMemory:     *resources.Memory(),
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -15,6 +15,17 @@ func ValidateBatchSchedulerConfig(logger logr.Logger, config Configuration) erro
 		return fmt.Errorf("both feature flags enable-batch-scheduler (deprecated) and batch-scheduler are set. Please use batch-scheduler only")
 	}
 
+	// the additional schedulers are selected by RayClusters with the ray.io/scheduler-name label
+	for _, name := range config.BatchSchedulers {
+		if !isSupportedBatchScheduler(name) {
+			return fmt.Errorf("scheduler is not supported, name=%s", name)
+		}
+	}
+	if len(config.BatchSchedulers) > 0 {
+		logger.Info("Feature flag batch-schedulers is enabled",
+			"scheduler names", config.BatchSchedulers)
+	}
+
 	if config.EnableBatchScheduler {
 		logger.Info("Feature flag enable-batch-scheduler is deprecated and will not be supported soon. " +
 			"Use batch-scheduler instead. ")
@@ -23,7 +34,7 @@ func ValidateBatchSchedulerConfig(logger logr.Logger, config Configuration) erro
 
 	if len(config.BatchScheduler) > 0 {
 		// if a customized scheduler is configured, check it is supported
-		if config.BatchScheduler == volcano.GetPluginName() || config.BatchScheduler == yunikorn.GetPluginName() || config.BatchScheduler == schedulerplugins.GetPluginName() {
+		if isSupportedBatchScheduler(config.BatchScheduler) {
 			logger.Info("Feature flag batch-scheduler is enabled",
 				"scheduler name", config.BatchScheduler)
 		} else {
@@ -33,3 +44,7 @@ func ValidateBatchSchedulerConfig(logger logr.Logger, config Configuration) erro
 
 	return nil
 }
+
+func isSupportedBatchScheduler(name string) bool {
+	return name == volcano.GetPluginName() || name == yunikorn.GetPluginName() || name == schedulerplugins.GetPluginName()
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/go-logr/logr/testr"
 
+	schedulerplugins "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/scheduler-plugins"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/volcano"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/yunikorn"
 )
@@ -82,6 +83,28 @@ func TestValidateBatchSchedulerConfig(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid option, additional batch schedulers",
+			args: args{
+				logger: testr.New(t),
+				config: Configuration{
+					BatchScheduler:  volcano.GetPluginName(),
+					BatchSchedulers: []string{yunikorn.GetPluginName(), schedulerplugins.GetPluginName()},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid option, invalid additional batch scheduler name",
+			args: args{
+				logger: testr.New(t),
+				config: Configuration{
+					EnableBatchScheduler: true,
+					BatchSchedulers:      []string{"unknown-scheduler-name"},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "both enable-batch-scheduler and batch-scheduler are set",
 			args: args{
@@ -5,6 +5,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
 )
 
@@ -47,6 +48,10 @@ type Configuration struct {
 	// based on the given name, currently, supported values are volcano and yunikorn.
 	BatchScheduler string `json:"batchScheduler,omitempty"`
 
+	// BatchSchedulers lists the additional batch schedulers that RayClusters can select with the
+	// `ray.io/scheduler-name` label. RayClusters without the label use BatchScheduler.
+	BatchSchedulers []string `json:"batchSchedulers,omitempty"`
+
 	// HeadSidecarContainers includes specification for a sidecar container
 	// to inject into every Head pod.
 	HeadSidecarContainers []corev1.Container `json:"headSidecarContainers,omitempty"`
@@ -73,6 +78,64 @@ type Configuration struct {
 
 	// EnableMetrics indicates whether KubeRay operator should emit control plane metrics.
 	EnableMetrics bool `json:"enableMetrics,omitempty"`
+
+	// MTLSCertificateIssuer is the issuer that signs the mTLS certificates of every RayCluster that doesn't
+	// set securityOptions.certificateIssuer. If empty, each RayCluster bootstraps its own self-signed CA.
+	MTLSCertificateIssuer *rayv1.CertificateIssuer `json:"mtlsCertificateIssuer,omitempty"`
+
+	// DashboardGateway configures the Gateway that routes to the Ray dashboards of the RayClusters with an auth
+	// proxy. Each field can be overridden per RayCluster with the ray.io/dashboard-* annotations.
+	DashboardGateway *DashboardGatewayConfig `json:"dashboardGateway,omitempty"`
+
+	// AuthProxy configures the auth proxy sidecars injected in front of the Ray dashboards. The head Pods are
+	// recreated when the sidecar it describes changes.
+	AuthProxy *AuthProxyConfig `json:"authProxy,omitempty"`
+
+	// MonitoringNamespace is the namespace of the Prometheus that scrapes the Ray Pods. The NetworkPolicies allow
+	// the metrics ports of the Ray Pods from this namespace. Defaults to the MONITORING_NAMESPACE environment
+	// variable.
+	MonitoringNamespace string `json:"monitoringNamespace,omitempty"`
+}
+
+// AuthProxyConfig overrides the images, pull policy, resources, and args of the auth proxy sidecars, e.g. to pull
+// them from a mirror registry in a disconnected cluster.
+type AuthProxyConfig struct {
+	// Resources of the auth proxy sidecars. Defaults to 10m CPU and 20Mi memory requests, and 200m CPU and 100Mi
+	// memory limits.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// OAuthProxyImage is the image of the OpenShift OAuth proxy sidecar.
+	OAuthProxyImage string `json:"oauthProxyImage,omitempty"`
+
+	// KubeRBACProxyImage is the image of the kube-rbac-proxy sidecar.
+	KubeRBACProxyImage string `json:"kubeRBACProxyImage,omitempty"`
+
+	// ImagePullPolicy of the auth proxy sidecars. Defaults to `IfNotPresent` if empty.
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+
+	// ExtraArgs are appended to the args of the auth proxy sidecars.
+	ExtraArgs []string `json:"extraArgs,omitempty"`
+}
+
+// DashboardGatewayConfig configures the parent Gateway, hostname, and path of the HTTPRoutes of the Ray dashboards.
+type DashboardGatewayConfig struct {
+	// Name of the parent Gateway of the HTTPRoutes. Defaults to `data-science-gateway` if empty.
+	Name string `json:"name,omitempty"`
+
+	// Namespace of the parent Gateway of the HTTPRoutes. Defaults to `openshift-ingress` if empty.
+	Namespace string `json:"namespace,omitempty"`
+
+	// SectionName is the name of the listener of the Gateway that the HTTPRoutes attach to. If empty, the
+	// HTTPRoutes attach to every listener of the Gateway.
+	SectionName string `json:"sectionName,omitempty"`
+
+	// Hostname that the HTTPRoutes match. It is also the host of the dashboard URL in the RayCluster status.
+	Hostname string `json:"hostname,omitempty"`
+
+	// PathTemplate is the path prefix of the dashboard of a RayCluster, in which `{namespace}` and `{name}` are
+	// replaced with the namespace and name of the RayCluster. It must contain both placeholders. Defaults to
+	// `/ray/{namespace}/{name}` if empty.
+	PathTemplate string `json:"pathTemplate,omitempty"`
 }
 
 func (config Configuration) GetDashboardClient(mgr manager.Manager) func() utils.RayDashboardClientInterface {
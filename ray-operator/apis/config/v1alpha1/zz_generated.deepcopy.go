@@ -5,10 +5,36 @@
 package v1alpha1
 
 import (
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
 	"k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthProxyConfig) DeepCopyInto(out *AuthProxyConfig) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExtraArgs != nil {
+		in, out := &in.ExtraArgs, &out.ExtraArgs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthProxyConfig.
+func (in *AuthProxyConfig) DeepCopy() *AuthProxyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthProxyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Configuration) DeepCopyInto(out *Configuration) {
 	*out = *in
@@ -18,6 +44,11 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.BatchSchedulers != nil {
+		in, out := &in.BatchSchedulers, &out.BatchSchedulers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.HeadSidecarContainers != nil {
 		in, out := &in.HeadSidecarContainers, &out.HeadSidecarContainers
 		*out = make([]v1.Container, len(*in))
@@ -32,6 +63,21 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.MTLSCertificateIssuer != nil {
+		in, out := &in.MTLSCertificateIssuer, &out.MTLSCertificateIssuer
+		*out = new(rayv1.CertificateIssuer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DashboardGateway != nil {
+		in, out := &in.DashboardGateway, &out.DashboardGateway
+		*out = new(DashboardGatewayConfig)
+		**out = **in
+	}
+	if in.AuthProxy != nil {
+		in, out := &in.AuthProxy, &out.AuthProxy
+		*out = new(AuthProxyConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Configuration.
@@ -51,3 +97,18 @@ func (in *Configuration) DeepCopyObject() runtime.Object {
 	}
 	return nil
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardGatewayConfig) DeepCopyInto(out *DashboardGatewayConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardGatewayConfig.
+func (in *DashboardGatewayConfig) DeepCopy() *DashboardGatewayConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardGatewayConfig)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -2,8 +2,10 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
@@ -15,6 +17,13 @@ type RayClusterSpec struct {
 	// A suspended RayCluster will have head pods and worker pods deleted.
 	// +optional
 	Suspend *bool `json:"suspend,omitempty"`
+	// IdleSuspendPolicy suspends the RayCluster once no Ray job, and optionally no Ray Serve application,
+	// has been running for the configured time.
+	// +optional
+	IdleSuspendPolicy *IdleSuspendPolicy `json:"idleSuspendPolicy,omitempty"`
+	// SuspendSchedule suspends and resumes the RayCluster at the times given by cron schedules.
+	// +optional
+	SuspendSchedule *SuspendSchedule `json:"suspendSchedule,omitempty"`
 	// ManagedBy is an optional configuration for the controller or entity that manages a RayCluster.
 	// The value must be either 'ray.io/kuberay-operator' or 'kueue.x-k8s.io/multikueue'.
 	// The kuberay-operator reconciles a RayCluster which doesn't have this field at all or
@@ -28,6 +37,14 @@ type RayClusterSpec struct {
 	// AutoscalerOptions specifies optional configuration for the Ray autoscaler.
 	// +optional
 	AutoscalerOptions *AutoscalerOptions `json:"autoscalerOptions,omitempty"`
+	// SecurityOptions configures the secure trusted network of the RayCluster, i.e. mTLS between Ray Pods,
+	// NetworkPolicies and the authentication proxy in front of the Ray dashboard.
+	// +optional
+	SecurityOptions *SecurityOptions `json:"securityOptions,omitempty"`
+	// NetworkPolicy adds user-defined rules to the NetworkPolicies of the secure trusted network. It only takes
+	// effect when the NetworkPolicies are enabled through securityOptions or the annotation.
+	// +optional
+	NetworkPolicy *NetworkPolicyOptions `json:"networkPolicy,omitempty"`
 	// +optional
 	HeadServiceAnnotations map[string]string `json:"headServiceAnnotations,omitempty"`
 	// EnableInTreeAutoscaling indicates whether operator should create in tree autoscaling configs
@@ -46,6 +63,168 @@ type RayClusterSpec struct {
 	WorkerGroupSpecs []WorkerGroupSpec `json:"workerGroupSpecs,omitempty"`
 }
 
+// IdleSuspendPolicy configures when an idle RayCluster is suspended. KubeRay polls the Ray dashboard for
+// running jobs and serve applications, and sets `suspend` to true once the RayCluster has been idle for
+// IdleTimeoutSeconds. The RayCluster is resumed by setting `suspend` back to false.
+type IdleSuspendPolicy struct {
+	// CountServeApplications indicates whether running Ray Serve applications keep the RayCluster active.
+	// Defaults to true.
+	// +optional
+	CountServeApplications *bool `json:"countServeApplications,omitempty"`
+	// IdleTimeoutSeconds is the number of seconds the RayCluster must stay idle before it is suspended.
+	// +kubebuilder:validation:Minimum=1
+	IdleTimeoutSeconds int32 `json:"idleTimeoutSeconds"`
+}
+
+// SecurityOptions toggles the components of the secure trusted network of a RayCluster. A toggle that is
+// not set falls back to the odh.ray.io/secure-trusted-network annotation.
+type SecurityOptions struct {
+	// MTLS enables mutual TLS between the Ray Pods.
+	// +optional
+	MTLS *bool `json:"mtls,omitempty"`
+	// NetworkPolicy enables the NetworkPolicies that restrict the traffic to the Ray Pods.
+	// +optional
+	NetworkPolicy *bool `json:"networkPolicy,omitempty"`
+	// AuthProxy enables the OAuth or OIDC proxy in front of the Ray dashboard.
+	// +optional
+	AuthProxy *bool `json:"authProxy,omitempty"`
+	// ServeAuthProxy puts the Ray Serve HTTP endpoint behind a second listener of the auth proxy, on port 8444 of
+	// the head Pod, which checks the same access as the dashboard. It requires the auth proxy.
+	// +optional
+	ServeAuthProxy *bool `json:"serveAuthProxy,omitempty"`
+	// ClientAuthProxy exposes the Ray client port through a listener of the auth proxy on port 10443 of the head
+	// Pod, which terminates TLS and authenticates the bearer token of each client. The NetworkPolicies then only
+	// allow the plain client port from the Ray Pods and the KubeRay operator. It requires the auth proxy.
+	// +optional
+	ClientAuthProxy *bool `json:"clientAuthProxy,omitempty"`
+	// CertificateSANs selects the Subject Alternative Names of the mTLS certificates. "PodIPs" lists the IP of
+	// every Ray Pod, so the certificates are re-issued whenever a Pod is created. "DNS" lists wildcard DNS names of
+	// the headless worker service and the FQDN of the head service, and the Ray Pods advertise those DNS names,
+	// so the certificates stay the same while the RayCluster scales. Default is "PodIPs".
+	// +optional
+	CertificateSANs *CertificateSANsType `json:"certificateSANs,omitempty"`
+	// CertificateIssuer signs the mTLS certificates with an existing issuer instead of a self-signed CA created
+	// for the RayCluster. It overrides the issuer set in the configuration of the operator.
+	// +optional
+	CertificateIssuer *CertificateIssuer `json:"certificateIssuer,omitempty"`
+	// DashboardAccessPolicy restricts who can reach the Ray dashboard through the auth proxy. If not set, every user
+	// who can get the Pods or the head service in the namespace of the RayCluster can reach the dashboard.
+	// +optional
+	DashboardAccessPolicy *DashboardAccessPolicy `json:"dashboardAccessPolicy,omitempty"`
+}
+
+// NetworkPolicyOptions lists the ingress rules that KubeRay adds to the rules it generates for the head and
+// worker NetworkPolicies, e.g. to allow notebooks of another namespace or the Prometheus of a monitoring
+// namespace, and restricts the egress traffic of the Ray Pods. The generated rules are always kept.
+type NetworkPolicyOptions struct {
+	// Egress restricts the traffic that leaves the Ray Pods. If not set, the NetworkPolicies do not restrict
+	// egress traffic.
+	// +optional
+	Egress *NetworkPolicyEgress `json:"egress,omitempty"`
+	// HeadIngress lists the ingress rules added to the NetworkPolicy of the head Pod. Every rule must list at
+	// least one peer.
+	// +optional
+	HeadIngress []networkingv1.NetworkPolicyIngressRule `json:"headIngress,omitempty"`
+	// WorkerIngress lists the ingress rules added to the NetworkPolicy of the worker Pods. Every rule must list
+	// at least one peer.
+	// +optional
+	WorkerIngress []networkingv1.NetworkPolicyIngressRule `json:"workerIngress,omitempty"`
+}
+
+// NetworkPolicyEgress denies the egress traffic of the Ray Pods except to the Pods of the RayCluster, DNS, the
+// Kubernetes API from the head Pod, the Redis of GCS fault tolerance from the head Pod, and the allowlist.
+// The Redis is reached on its IP, on the Pods of its namespace for a Service name, e.g. "redis:6379" or
+// "redis.db.svc:6379", and on its port to any destination for other host names.
+type NetworkPolicyEgress struct {
+	// Allow lists the egress rules added to the NetworkPolicies of the head and worker Pods, e.g. to reach an
+	// object store or a package index. Every rule must list at least one peer or port.
+	// +optional
+	Allow []networkingv1.NetworkPolicyEgressRule `json:"allow,omitempty"`
+}
+
+// DashboardAccessPolicy lists the users and groups allowed to access the Ray dashboard, or the resource and verb
+// of the SubjectAccessReview that the auth proxy checks for each request.
+type DashboardAccessPolicy struct {
+	// Users allowed to access the Ray dashboard. KubeRay grants them access with a Role and a RoleBinding on the
+	// rayclusters/dashboard subresource of the RayCluster.
+	// +optional
+	Users []string `json:"users,omitempty"`
+	// Groups whose members are allowed to access the Ray dashboard.
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+	// ResourceAttributes replaces the SubjectAccessReview of the auth proxy with a check on a custom resource and
+	// verb, e.g. to reuse an existing Role of the team. It cannot be set together with users or groups. Changes
+	// take effect when the head Pod is recreated.
+	// +optional
+	ResourceAttributes *DashboardResourceAttributes `json:"resourceAttributes,omitempty"`
+}
+
+// DashboardResourceAttributes are the attributes of the SubjectAccessReview that the auth proxy checks in the
+// namespace of the RayCluster.
+type DashboardResourceAttributes struct {
+	// Verb is the verb checked by the SubjectAccessReview. Default is "get".
+	// +optional
+	Verb string `json:"verb,omitempty"`
+	// Group is the API group of the resource. Default is the core group.
+	// +optional
+	Group string `json:"group,omitempty"`
+	// Resource is the resource checked by the SubjectAccessReview, e.g. "services".
+	Resource string `json:"resource"`
+	// Subresource of the resource.
+	// +optional
+	Subresource string `json:"subresource,omitempty"`
+	// Name of the resource. If not set, the SubjectAccessReview checks all the resources of the namespace.
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// CertificateIssuer references an existing cert-manager issuer, e.g. a ClusterIssuer of a corporate CA, a Vault
+// Issuer, or a CA Issuer shared by several RayClusters. KubeRay never deletes the referenced issuer.
+type CertificateIssuer struct {
+	// Name of the issuer.
+	Name string `json:"name"`
+	// Kind of the issuer, e.g. "Issuer" or "ClusterIssuer". An Issuer must be in the namespace of the RayCluster.
+	// Default is "Issuer".
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// Group of the issuer. Default is "cert-manager.io".
+	// +optional
+	Group string `json:"group,omitempty"`
+	// TrustBundle is a key of a ConfigMap in the namespace of the RayCluster with the CA certificates trusted by
+	// the Ray Pods, e.g. a bundle distributed by trust-manager. If not set, the Ray Pods trust the ca.crt of their
+	// certificate Secrets.
+	// +optional
+	TrustBundle *corev1.ConfigMapKeySelector `json:"trustBundle,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=PodIPs;DNS
+type CertificateSANsType string
+
+const (
+	// PodIPsCertificateSANs lists the IPs of the Ray Pods in the mTLS certificates.
+	PodIPsCertificateSANs CertificateSANsType = "PodIPs"
+	// DNSCertificateSANs lists the DNS names of the Ray services in the mTLS certificates.
+	DNSCertificateSANs CertificateSANsType = "DNS"
+)
+
+// SuspendSchedule configures when a RayCluster is suspended and resumed. At each scheduled time, KubeRay
+// sets `suspend` to true or false respectively. Changes made to `suspend` in between are kept until the
+// next scheduled time.
+type SuspendSchedule struct {
+	// Suspend is the cron schedule, in the standard five-field format, at which the RayCluster is suspended,
+	// e.g. "0 20 * * 1-5" for 20:00 on weekdays.
+	// +optional
+	Suspend string `json:"suspend,omitempty"`
+	// Resume is the cron schedule, in the standard five-field format, at which the RayCluster is resumed,
+	// e.g. "0 7 * * 1-5" for 07:00 on weekdays.
+	// +optional
+	Resume string `json:"resume,omitempty"`
+	// TimeZone is the name of the time zone in which the schedules are interpreted, e.g. "Europe/Paris".
+	// Defaults to UTC.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+}
+
 // GcsFaultToleranceOptions contains configs for GCS FT
 type GcsFaultToleranceOptions struct {
 	// +optional
@@ -81,6 +260,18 @@ type HeadGroupSpec struct {
 	// ServiceType is Kubernetes service type of the head service. it will be used by the workers to connect to the head pod
 	// +optional
 	ServiceType corev1.ServiceType `json:"serviceType,omitempty"`
+	// DisruptionBudget, if set, makes the operator create a PodDisruptionBudget for the head Pod.
+	// +optional
+	DisruptionBudget *DisruptionBudget `json:"disruptionBudget,omitempty"`
+	// VolumeClaimTemplates is a list of PersistentVolumeClaims from which the operator creates one claim per Pod,
+	// like the volumeClaimTemplates of a StatefulSet. Containers mount a claim through a volume with the same name as its template.
+	// Claims are named `<template>-<cluster>-<group>-<index>`, where each Pod of the group gets the lowest index not in use,
+	// so a Pod replacing a deleted Pod mounts the claims retained from it.
+	// +optional
+	VolumeClaimTemplates []corev1.PersistentVolumeClaim `json:"volumeClaimTemplates,omitempty"`
+	// VolumeClaimRetentionPolicy describes the lifecycle of the PersistentVolumeClaims created from VolumeClaimTemplates.
+	// +optional
+	VolumeClaimRetentionPolicy *VolumeClaimRetentionPolicy `json:"volumeClaimRetentionPolicy,omitempty"`
 }
 
 // WorkerGroupSpec are the specs for the worker pods
@@ -118,6 +309,28 @@ type WorkerGroupSpec struct {
 	// +kubebuilder:default:=1
 	// +optional
 	NumOfHosts int32 `json:"numOfHosts,omitempty"`
+	// UpdateStrategy defines how existing worker Pods are replaced when the worker group template changes.
+	// If not set, existing Pods keep running with the old template until they are deleted.
+	// +optional
+	UpdateStrategy *WorkerGroupUpdateStrategy `json:"updateStrategy,omitempty"`
+	// DisruptionBudget, if set, makes the operator create a PodDisruptionBudget for the Pods of this worker group.
+	// +optional
+	DisruptionBudget *DisruptionBudget `json:"disruptionBudget,omitempty"`
+	// DrainTimeoutSeconds, if set, makes the operator drain the Ray nodes of the Pods in ScaleStrategy.WorkersToDelete
+	// through the Ray dashboard before deleting the Pods. A Pod is deleted once its Ray node has exited or after
+	// DrainTimeoutSeconds, whichever comes first. If not set, the Pods are deleted without draining.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	DrainTimeoutSeconds *int32 `json:"drainTimeoutSeconds,omitempty"`
+	// VolumeClaimTemplates is a list of PersistentVolumeClaims from which the operator creates one claim per Pod,
+	// like the volumeClaimTemplates of a StatefulSet. Containers mount a claim through a volume with the same name as its template.
+	// Claims are named `<template>-<cluster>-<group>-<index>`, where each Pod of the group gets the lowest index not in use,
+	// so a Pod replacing a deleted Pod mounts the claims retained from it.
+	// +optional
+	VolumeClaimTemplates []corev1.PersistentVolumeClaim `json:"volumeClaimTemplates,omitempty"`
+	// VolumeClaimRetentionPolicy describes the lifecycle of the PersistentVolumeClaims created from VolumeClaimTemplates.
+	// +optional
+	VolumeClaimRetentionPolicy *VolumeClaimRetentionPolicy `json:"volumeClaimRetentionPolicy,omitempty"`
 }
 
 // ScaleStrategy to remove workers
@@ -126,6 +339,79 @@ type ScaleStrategy struct {
 	WorkersToDelete []string `json:"workersToDelete,omitempty"`
 }
 
+// +kubebuilder:validation:Enum=OnDelete;Recreate;RollingUpdate
+type WorkerGroupUpdateStrategyType string
+
+const (
+	// OnDeleteUpdateStrategy keeps outdated worker Pods until they are deleted by users or the autoscaler.
+	OnDeleteUpdateStrategy WorkerGroupUpdateStrategyType = "OnDelete"
+	// RecreateUpdateStrategy deletes all outdated worker Pods of the group at once and then creates new ones.
+	RecreateUpdateStrategy WorkerGroupUpdateStrategyType = "Recreate"
+	// RollingUpdateStrategy replaces outdated worker Pods in batches bounded by MaxUnavailable and MaxSurge.
+	RollingUpdateStrategy WorkerGroupUpdateStrategyType = "RollingUpdate"
+)
+
+// WorkerGroupUpdateStrategy describes how to replace worker Pods whose template is outdated.
+type WorkerGroupUpdateStrategy struct {
+	// Type of the update strategy. Can be "OnDelete", "Recreate" or "RollingUpdate". Default is "OnDelete".
+	// +optional
+	Type *WorkerGroupUpdateStrategyType `json:"type,omitempty"`
+	// RollingUpdate configures the batch size of the rollout. Only used when Type is "RollingUpdate".
+	// +optional
+	RollingUpdate *RollingUpdateWorkerGroupStrategy `json:"rollingUpdate,omitempty"`
+}
+
+// RollingUpdateWorkerGroupStrategy controls the batch size of a worker group rolling update.
+type RollingUpdateWorkerGroupStrategy struct {
+	// MaxUnavailable is the maximum number of worker Pods that can be unavailable during the update.
+	// Value can be an absolute number (ex: 5) or a percentage of desired Pods (ex: 10%).
+	// The absolute number is calculated from percentage by rounding down. Defaults to 25%.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	// MaxSurge is the maximum number of worker Pods that can be created over the desired number of Pods.
+	// Value can be an absolute number (ex: 5) or a percentage of desired Pods (ex: 10%).
+	// The absolute number is calculated from percentage by rounding up. Defaults to 25%.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+}
+
+// DisruptionBudget limits the voluntary disruptions of a group, such as evictions caused by node drains.
+// Both fields are counted in replicas. For multi-host worker groups, a replica is made up of NumOfHosts Pods,
+// and evicting any one of them disrupts the whole replica. At most one of MinAvailable and MaxUnavailable may be set.
+// If neither is set, MaxUnavailable defaults to 1.
+type DisruptionBudget struct {
+	// MinAvailable is the number of replicas that must stay available after an eviction.
+	// Value can be an absolute number (ex: 5) or a percentage of desired replicas (ex: 10%), rounded up.
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+	// MaxUnavailable is the number of replicas that can be unavailable after an eviction.
+	// Value can be an absolute number (ex: 5) or a percentage of desired replicas (ex: 10%), rounded up.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Retain;Delete
+type VolumeClaimRetentionPolicyType string
+
+const (
+	// RetainVolumeClaimRetentionPolicy keeps the PersistentVolumeClaims.
+	RetainVolumeClaimRetentionPolicy VolumeClaimRetentionPolicyType = "Retain"
+	// DeleteVolumeClaimRetentionPolicy deletes the PersistentVolumeClaims.
+	DeleteVolumeClaimRetentionPolicy VolumeClaimRetentionPolicyType = "Delete"
+)
+
+// VolumeClaimRetentionPolicy describes when the PersistentVolumeClaims created from VolumeClaimTemplates are deleted.
+// Since Pods are deleted together with their RayCluster, WhenPodDeleted can only be "Delete" if WhenClusterDeleted is "Delete" too.
+type VolumeClaimRetentionPolicy struct {
+	// WhenPodDeleted specifies what happens to the claims of a Pod when the Pod is deleted. Retained claims are mounted
+	// by the next Pod created with the same index. Default is "Delete".
+	// +optional
+	WhenPodDeleted *VolumeClaimRetentionPolicyType `json:"whenPodDeleted,omitempty"`
+	// WhenClusterDeleted specifies what happens to the claims when the RayCluster is deleted. Default is "Delete".
+	// +optional
+	WhenClusterDeleted *VolumeClaimRetentionPolicyType `json:"whenClusterDeleted,omitempty"`
+}
+
 // AutoscalerOptions specifies optional configuration for the Ray autoscaler.
 type AutoscalerOptions struct {
 	// Resources specifies optional resource request and limit overrides for the autoscaler container.
@@ -261,12 +547,90 @@ type RayClusterStatus struct {
 	// It is named "replicas" to maintain backward compatibility.
 	// +optional
 	MaxWorkerReplicas int32 `json:"maxWorkerReplicas,omitempty"`
+	// UpdatedWorkerReplicas indicates the number of worker Pods created from the current template of their worker group.
+	// +optional
+	UpdatedWorkerReplicas int32 `json:"updatedWorkerReplicas,omitempty"`
+	// DrainingWorkerReplicas indicates the number of worker Pods whose Ray nodes are being drained before deletion.
+	// +optional
+	DrainingWorkerReplicas int32 `json:"drainingWorkerReplicas,omitempty"`
+	// WorkerGroupStatuses reports the observed state of each worker group.
+	// +listType=map
+	// +listMapKey=groupName
+	// +optional
+	WorkerGroupStatuses []WorkerGroupStatus `json:"workerGroupStatuses,omitempty"`
+	// IdleSince is the time since which the RayCluster has been observed idle, i.e. without running Ray jobs
+	// and, if counted, serve applications. It is only set for a RayCluster with an idleSuspendPolicy.
+	// +optional
+	IdleSince *metav1.Time `json:"idleSince,omitempty"`
+	// NextScheduledAction is the next action of the suspendSchedule of the RayCluster.
+	// +optional
+	NextScheduledAction *ScheduledAction `json:"nextScheduledAction,omitempty"`
+	// Security reports the effective security settings of the RayCluster.
+	// +optional
+	Security *SecurityStatus `json:"security,omitempty"`
+	// Certificates reports the mTLS certificates of the RayCluster. It is only set when mTLS is enabled.
+	// +optional
+	Certificates *CertificatesStatus `json:"certificates,omitempty"`
 	// observedGeneration is the most recent generation observed for this RayCluster. It corresponds to the
 	// RayCluster's generation, which is updated on mutation by the API Server.
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
+// SecurityStatus reports which components of the secure trusted network are enabled for a RayCluster.
+type SecurityStatus struct {
+	// AuthenticationMode is the authentication mode of the auth proxy, either IntegratedOAuth or OIDC.
+	// It is only set when the auth proxy is enabled.
+	// +optional
+	AuthenticationMode string `json:"authenticationMode,omitempty"`
+	// MTLSEnabled indicates whether mutual TLS is enabled between the Ray Pods.
+	// +optional
+	MTLSEnabled bool `json:"mtlsEnabled,omitempty"`
+	// NetworkPolicyEnabled indicates whether NetworkPolicies restrict the traffic to the Ray Pods.
+	// +optional
+	NetworkPolicyEnabled bool `json:"networkPolicyEnabled,omitempty"`
+	// AuthProxyEnabled indicates whether the auth proxy is deployed in front of the Ray dashboard.
+	// +optional
+	AuthProxyEnabled bool `json:"authProxyEnabled,omitempty"`
+}
+
+// CertificatesStatus reports the mTLS certificates used by the Ray Pods of a RayCluster.
+type CertificatesStatus struct {
+	// Hash is the hash of the CA bundles of the mTLS certificate Secrets of the RayCluster.
+	// +optional
+	Hash string `json:"hash,omitempty"`
+	// LastRotationTime is the time at which KubeRay last detected that the CA bundles changed or that the certificates
+	// were renewed. Ray Pods created before then are restarted so that they load the renewed certificates.
+	// +optional
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+	// LoadedNotAfter is the expiration time of the oldest certificate that the Ray Pods created since LastRotationTime
+	// may have loaded. Certificates reissued only for new SANs, e.g. new Pod IPs, don't restart the Pods until then.
+	// +optional
+	LoadedNotAfter *metav1.Time `json:"loadedNotAfter,omitempty"`
+	// NotAfter is the expiration time of the certificate of the RayCluster that expires first.
+	// +optional
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+	// TimeUntilExpiry is the time left before NotAfter, rounded down to the hour.
+	// +optional
+	TimeUntilExpiry *metav1.Duration `json:"timeUntilExpiry,omitempty"`
+}
+
+// ScheduledActionType is the action taken on a RayCluster at a time of its suspendSchedule.
+type ScheduledActionType string
+
+const (
+	ScheduledSuspend ScheduledActionType = "Suspend"
+	ScheduledResume  ScheduledActionType = "Resume"
+)
+
+// ScheduledAction is an action of a suspendSchedule and the time at which it is taken.
+type ScheduledAction struct {
+	// Time is when the action is taken.
+	Time metav1.Time `json:"time"`
+	// Action is either Suspend or Resume.
+	Action ScheduledActionType `json:"action"`
+}
+
 type RayClusterConditionType string
 
 // Custom Reason for RayClusterCondition
@@ -275,6 +639,17 @@ const (
 	RayClusterPodsProvisioning     = "RayClusterPodsProvisioning"
 	HeadPodNotFound                = "HeadPodNotFound"
 	HeadPodRunningAndReady         = "HeadPodRunningAndReady"
+	WorkerPodsOutdated             = "WorkerPodsOutdated"
+	AllWorkerPodsUpdated           = "AllWorkerPodsUpdated"
+	WorkerPodsUnschedulable        = "WorkerPodsUnschedulable"
+	RayPodsImagePullFailed         = "RayPodsImagePullFailed"
+	HeadPodCrashLoopBackOff        = "HeadPodCrashLoopBackOff"
+	IdleTimeoutExceeded            = "IdleTimeoutExceeded"
+	CertificatesIssued             = "CertificatesIssued"
+	CertificatesPending            = "CertificatesPending"
+	CertificatesIssuanceFailed     = "CertificatesIssuanceFailed"
+	CertificatesValid              = "CertificatesValid"
+	CertificateExpiring            = "CertificateExpiring"
 	// UnknownReason says that the reason for the condition is unknown.
 	UnknownReason = "Unknown"
 )
@@ -291,6 +666,23 @@ const (
 	RayClusterSuspending RayClusterConditionType = "RayClusterSuspending"
 	// RayClusterSuspended is set to true when all Pods belonging to a suspending RayCluster are deleted. Note that RayClusterSuspending and RayClusterSuspended cannot both be true at the same time.
 	RayClusterSuspended RayClusterConditionType = "RayClusterSuspended"
+	// RayClusterWorkerGroupsUpdating is set to true while worker groups with an UpdateStrategy still have Pods created from an outdated template.
+	RayClusterWorkerGroupsUpdating RayClusterConditionType = "WorkerGroupsUpdating"
+	// RayClusterWorkersUnschedulable is added in a RayCluster when some of its worker Pods cannot be scheduled.
+	RayClusterWorkersUnschedulable RayClusterConditionType = "WorkersUnschedulable"
+	// RayClusterImagePullFailure is added in a RayCluster when some of its Pods fail to pull their container images.
+	RayClusterImagePullFailure RayClusterConditionType = "ImagePullFailure"
+	// RayClusterHeadCrashLooping is added in a RayCluster when a container of its head Pod is in CrashLoopBackOff.
+	RayClusterHeadCrashLooping RayClusterConditionType = "HeadCrashLooping"
+	// RayClusterIdleSuspended is set to true when KubeRay suspends a RayCluster because of its idleSuspendPolicy.
+	// It is removed when the RayCluster is resumed.
+	RayClusterIdleSuspended RayClusterConditionType = "IdleSuspended"
+	// RayClusterCertificatesReady indicates whether the mTLS certificates of the RayCluster are issued and ready
+	// to be mounted by its Pods. It is only set when mTLS is enabled.
+	RayClusterCertificatesReady RayClusterConditionType = "CertificatesReady"
+	// RayClusterCertificateExpiringSoon is set to true when one of the mTLS certificates of the RayCluster expires
+	// soon, e.g. because it could not be renewed. It is only set when mTLS is enabled.
+	RayClusterCertificateExpiringSoon RayClusterConditionType = "CertificateExpiringSoon"
 )
 
 // HeadInfo gives info about head
@@ -305,6 +697,36 @@ type HeadInfo struct {
 	ServiceName string `json:"serviceName,omitempty"`
 }
 
+// WorkerGroupStatus gives the observed state of a worker group. Like the cluster-level fields,
+// the numbers count worker Pods, i.e. `replicas * numOfHosts` for a multi-host group.
+type WorkerGroupStatus struct {
+	// GroupName is the name of the worker group.
+	GroupName string `json:"groupName"`
+	// LastFailureReason is the reason of the most recent failure among the worker group's Pods,
+	// e.g. Unschedulable, ImagePullBackOff or CrashLoopBackOff. It is cleared once no Pod is failing.
+	// +optional
+	LastFailureReason string `json:"lastFailureReason,omitempty"`
+	// DesiredReplicas indicates the desired number of worker Pods in the group.
+	// +optional
+	DesiredReplicas int32 `json:"desiredReplicas,omitempty"`
+	// ReadyReplicas indicates the number of worker Pods in the group that are running and ready.
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+	// AvailableReplicas indicates the number of worker Pods in the group that are running.
+	// +optional
+	AvailableReplicas int32 `json:"availableReplicas,omitempty"`
+	// PendingReplicas indicates the number of worker Pods in the group that are pending.
+	// +optional
+	PendingReplicas int32 `json:"pendingReplicas,omitempty"`
+	// FailedReplicas indicates the number of worker Pods in the group that have failed.
+	// +optional
+	FailedReplicas int32 `json:"failedReplicas,omitempty"`
+	// ReadyMultiHostReplicas indicates, for a group with numOfHosts > 1, the number of replicas whose
+	// hosts are all running and ready. Unlike the other fields, it counts replicas rather than Pods.
+	// +optional
+	ReadyMultiHostReplicas int32 `json:"readyMultiHostReplicas,omitempty"`
+}
+
 // RayNodeType  the type of a ray node: head/worker
 type RayNodeType string
 
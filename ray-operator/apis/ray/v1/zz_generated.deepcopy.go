@@ -6,8 +6,10 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -103,6 +105,128 @@ func (in *AutoscalerOptions) DeepCopy() *AutoscalerOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateIssuer) DeepCopyInto(out *CertificateIssuer) {
+	*out = *in
+	if in.TrustBundle != nil {
+		in, out := &in.TrustBundle, &out.TrustBundle
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateIssuer.
+func (in *CertificateIssuer) DeepCopy() *CertificateIssuer {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateIssuer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificatesStatus) DeepCopyInto(out *CertificatesStatus) {
+	*out = *in
+	if in.LastRotationTime != nil {
+		in, out := &in.LastRotationTime, &out.LastRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LoadedNotAfter != nil {
+		in, out := &in.LoadedNotAfter, &out.LoadedNotAfter
+		*out = (*in).DeepCopy()
+	}
+	if in.NotAfter != nil {
+		in, out := &in.NotAfter, &out.NotAfter
+		*out = (*in).DeepCopy()
+	}
+	if in.TimeUntilExpiry != nil {
+		in, out := &in.TimeUntilExpiry, &out.TimeUntilExpiry
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatesStatus.
+func (in *CertificatesStatus) DeepCopy() *CertificatesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificatesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardAccessPolicy) DeepCopyInto(out *DashboardAccessPolicy) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResourceAttributes != nil {
+		in, out := &in.ResourceAttributes, &out.ResourceAttributes
+		*out = new(DashboardResourceAttributes)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardAccessPolicy.
+func (in *DashboardAccessPolicy) DeepCopy() *DashboardAccessPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardAccessPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardResourceAttributes) DeepCopyInto(out *DashboardResourceAttributes) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardResourceAttributes.
+func (in *DashboardResourceAttributes) DeepCopy() *DashboardResourceAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardResourceAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DisruptionBudget) DeepCopyInto(out *DisruptionBudget) {
+	*out = *in
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DisruptionBudget.
+func (in *DisruptionBudget) DeepCopy() *DisruptionBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(DisruptionBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GcsFaultToleranceOptions) DeepCopyInto(out *GcsFaultToleranceOptions) {
 	*out = *in
@@ -149,6 +273,23 @@ func (in *HeadGroupSpec) DeepCopyInto(out *HeadGroupSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.DisruptionBudget != nil {
+		in, out := &in.DisruptionBudget, &out.DisruptionBudget
+		*out = new(DisruptionBudget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VolumeClaimTemplates != nil {
+		in, out := &in.VolumeClaimTemplates, &out.VolumeClaimTemplates
+		*out = make([]corev1.PersistentVolumeClaim, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeClaimRetentionPolicy != nil {
+		in, out := &in.VolumeClaimRetentionPolicy, &out.VolumeClaimRetentionPolicy
+		*out = new(VolumeClaimRetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeadGroupSpec.
@@ -176,6 +317,82 @@ func (in *HeadInfo) DeepCopy() *HeadInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdleSuspendPolicy) DeepCopyInto(out *IdleSuspendPolicy) {
+	*out = *in
+	if in.CountServeApplications != nil {
+		in, out := &in.CountServeApplications, &out.CountServeApplications
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdleSuspendPolicy.
+func (in *IdleSuspendPolicy) DeepCopy() *IdleSuspendPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(IdleSuspendPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyEgress) DeepCopyInto(out *NetworkPolicyEgress) {
+	*out = *in
+	if in.Allow != nil {
+		in, out := &in.Allow, &out.Allow
+		*out = make([]networkingv1.NetworkPolicyEgressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyEgress.
+func (in *NetworkPolicyEgress) DeepCopy() *NetworkPolicyEgress {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyEgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyOptions) DeepCopyInto(out *NetworkPolicyOptions) {
+	*out = *in
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(NetworkPolicyEgress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HeadIngress != nil {
+		in, out := &in.HeadIngress, &out.HeadIngress
+		*out = make([]networkingv1.NetworkPolicyIngressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.WorkerIngress != nil {
+		in, out := &in.WorkerIngress, &out.WorkerIngress
+		*out = make([]networkingv1.NetworkPolicyIngressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyOptions.
+func (in *NetworkPolicyOptions) DeepCopy() *NetworkPolicyOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RayCluster) DeepCopyInto(out *RayCluster) {
 	*out = *in
@@ -243,6 +460,16 @@ func (in *RayClusterSpec) DeepCopyInto(out *RayClusterSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.IdleSuspendPolicy != nil {
+		in, out := &in.IdleSuspendPolicy, &out.IdleSuspendPolicy
+		*out = new(IdleSuspendPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SuspendSchedule != nil {
+		in, out := &in.SuspendSchedule, &out.SuspendSchedule
+		*out = new(SuspendSchedule)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ManagedBy != nil {
 		in, out := &in.ManagedBy, &out.ManagedBy
 		*out = new(string)
@@ -253,6 +480,16 @@ func (in *RayClusterSpec) DeepCopyInto(out *RayClusterSpec) {
 		*out = new(AutoscalerOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SecurityOptions != nil {
+		in, out := &in.SecurityOptions, &out.SecurityOptions
+		*out = new(SecurityOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(NetworkPolicyOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.HeadServiceAnnotations != nil {
 		in, out := &in.HeadServiceAnnotations, &out.HeadServiceAnnotations
 		*out = make(map[string]string, len(*in))
@@ -323,6 +560,30 @@ func (in *RayClusterStatus) DeepCopyInto(out *RayClusterStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.WorkerGroupStatuses != nil {
+		in, out := &in.WorkerGroupStatuses, &out.WorkerGroupStatuses
+		*out = make([]WorkerGroupStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.IdleSince != nil {
+		in, out := &in.IdleSince, &out.IdleSince
+		*out = (*in).DeepCopy()
+	}
+	if in.NextScheduledAction != nil {
+		in, out := &in.NextScheduledAction, &out.NextScheduledAction
+		*out = new(ScheduledAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = new(SecurityStatus)
+		**out = **in
+	}
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = new(CertificatesStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayClusterStatus.
@@ -702,6 +963,31 @@ func (in *RedisCredential) DeepCopy() *RedisCredential {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollingUpdateWorkerGroupStrategy) DeepCopyInto(out *RollingUpdateWorkerGroupStrategy) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollingUpdateWorkerGroupStrategy.
+func (in *RollingUpdateWorkerGroupStrategy) DeepCopy() *RollingUpdateWorkerGroupStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(RollingUpdateWorkerGroupStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScaleStrategy) DeepCopyInto(out *ScaleStrategy) {
 	*out = *in
@@ -722,6 +1008,92 @@ func (in *ScaleStrategy) DeepCopy() *ScaleStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduledAction) DeepCopyInto(out *ScheduledAction) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledAction.
+func (in *ScheduledAction) DeepCopy() *ScheduledAction {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduledAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityOptions) DeepCopyInto(out *SecurityOptions) {
+	*out = *in
+	if in.MTLS != nil {
+		in, out := &in.MTLS, &out.MTLS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AuthProxy != nil {
+		in, out := &in.AuthProxy, &out.AuthProxy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ServeAuthProxy != nil {
+		in, out := &in.ServeAuthProxy, &out.ServeAuthProxy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ClientAuthProxy != nil {
+		in, out := &in.ClientAuthProxy, &out.ClientAuthProxy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CertificateSANs != nil {
+		in, out := &in.CertificateSANs, &out.CertificateSANs
+		*out = new(CertificateSANsType)
+		**out = **in
+	}
+	if in.CertificateIssuer != nil {
+		in, out := &in.CertificateIssuer, &out.CertificateIssuer
+		*out = new(CertificateIssuer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DashboardAccessPolicy != nil {
+		in, out := &in.DashboardAccessPolicy, &out.DashboardAccessPolicy
+		*out = new(DashboardAccessPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityOptions.
+func (in *SecurityOptions) DeepCopy() *SecurityOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityStatus) DeepCopyInto(out *SecurityStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityStatus.
+func (in *SecurityStatus) DeepCopy() *SecurityStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServeDeploymentStatus) DeepCopyInto(out *ServeDeploymentStatus) {
 	*out = *in
@@ -757,6 +1129,51 @@ func (in *SubmitterConfig) DeepCopy() *SubmitterConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SuspendSchedule) DeepCopyInto(out *SuspendSchedule) {
+	*out = *in
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SuspendSchedule.
+func (in *SuspendSchedule) DeepCopy() *SuspendSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(SuspendSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeClaimRetentionPolicy) DeepCopyInto(out *VolumeClaimRetentionPolicy) {
+	*out = *in
+	if in.WhenPodDeleted != nil {
+		in, out := &in.WhenPodDeleted, &out.WhenPodDeleted
+		*out = new(VolumeClaimRetentionPolicyType)
+		**out = **in
+	}
+	if in.WhenClusterDeleted != nil {
+		in, out := &in.WhenClusterDeleted, &out.WhenClusterDeleted
+		*out = new(VolumeClaimRetentionPolicyType)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeClaimRetentionPolicy.
+func (in *VolumeClaimRetentionPolicy) DeepCopy() *VolumeClaimRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeClaimRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerGroupSpec) DeepCopyInto(out *WorkerGroupSpec) {
 	*out = *in
@@ -794,6 +1211,33 @@ func (in *WorkerGroupSpec) DeepCopyInto(out *WorkerGroupSpec) {
 	}
 	in.Template.DeepCopyInto(&out.Template)
 	in.ScaleStrategy.DeepCopyInto(&out.ScaleStrategy)
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(WorkerGroupUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DisruptionBudget != nil {
+		in, out := &in.DisruptionBudget, &out.DisruptionBudget
+		*out = new(DisruptionBudget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DrainTimeoutSeconds != nil {
+		in, out := &in.DrainTimeoutSeconds, &out.DrainTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.VolumeClaimTemplates != nil {
+		in, out := &in.VolumeClaimTemplates, &out.VolumeClaimTemplates
+		*out = make([]corev1.PersistentVolumeClaim, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeClaimRetentionPolicy != nil {
+		in, out := &in.VolumeClaimRetentionPolicy, &out.VolumeClaimRetentionPolicy
+		*out = new(VolumeClaimRetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerGroupSpec.
@@ -805,3 +1249,43 @@ func (in *WorkerGroupSpec) DeepCopy() *WorkerGroupSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerGroupStatus) DeepCopyInto(out *WorkerGroupStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerGroupStatus.
+func (in *WorkerGroupStatus) DeepCopy() *WorkerGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerGroupUpdateStrategy) DeepCopyInto(out *WorkerGroupUpdateStrategy) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(WorkerGroupUpdateStrategyType)
+		**out = **in
+	}
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(RollingUpdateWorkerGroupStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerGroupUpdateStrategy.
+func (in *WorkerGroupUpdateStrategy) DeepCopy() *WorkerGroupUpdateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerGroupUpdateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
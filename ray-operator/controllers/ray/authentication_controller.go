@@ -10,11 +10,14 @@ import (
 	"fmt"
 	"math/big"
 	"net"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
+	configv1 "github.com/openshift/api/config/v1"
 	routev1 "github.com/openshift/api/route/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -25,11 +28,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	configapi "github.com/ray-project/kuberay/ray-operator/apis/config/v1alpha1"
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
 )
@@ -47,10 +52,12 @@ const (
 
 	oauthConfigVolumeName = "oauth-config"
 
-	oidcProxyContainerName  = "kube-rbac-proxy"
-	oidcProxyPortName       = "https"
-	oauthProxyImage         = "registry.redhat.io/openshift4/ose-oauth-proxy:latest"
-	oidcProxyContainerImage = "registry.redhat.io/openshift4/ose-kube-rbac-proxy-rhel9@sha256:11828cdb31cd9c1e15bc9e31c7e4669daf71c84c028cad2df5dbab68150da273"
+	oidcProxyContainerName   = "kube-rbac-proxy"
+	serveProxyContainerName  = "kube-rbac-proxy-serve"
+	clientProxyContainerName = "kube-rbac-proxy-client"
+	oidcProxyPortName        = "https"
+	oauthProxyImage          = "registry.redhat.io/openshift4/ose-oauth-proxy:latest"
+	oidcProxyContainerImage  = "registry.redhat.io/openshift4/ose-kube-rbac-proxy-rhel9@sha256:11828cdb31cd9c1e15bc9e31c7e4669daf71c84c028cad2df5dbab68150da273"
 )
 
 // AuthenticationController is a completely independent controller that watches authentication-related
@@ -80,6 +87,7 @@ func NewAuthenticationController(mgr manager.Manager, options RayClusterReconcil
 // +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;update;patch;delete
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles;clusterrolebindings,verbs=get;list;watch
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles;rolebindings,verbs=get;list;watch;create;update;delete
 // +kubebuilder:rbac:groups=route.openshift.io,resources=routes,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=operator.openshift.io,resources=kubeapiservers,verbs=get;list;watch
 // +kubebuilder:rbac:groups=operator.openshift.io,resources=kubeapiservers/status,verbs=get;list;watch
@@ -90,6 +98,7 @@ func NewAuthenticationController(mgr manager.Manager, options RayClusterReconcil
 // +kubebuilder:rbac:groups=authentication.k8s.io,resources=tokenreviews,verbs=create
 // +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
 // +kubebuilder:rbac:groups=ray.io,resources=rayclusters,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=ray.io,resources=rayclusters/dashboard,verbs=get
 // +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways,verbs=get;list;watch
 // +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;list;watch;create;update;patch;delete
@@ -117,12 +126,15 @@ func (r *AuthenticationController) Reconcile(ctx context.Context, req ctrl.Reque
 	}
 
 	// Detect the authentication mode configured in the cluster
-	authMode := utils.DetectAuthenticationMode(r.options.IsOpenShift)
+	authMode, err := utils.DetectAuthenticationMode(ctx, r, r.options.IsOpenShift)
+	if err != nil {
+		logger.Error(err, "Failed to detect authentication mode")
+		return ctrl.Result{RequeueAfter: MediumRequeueDelay}, err
+	}
 	logger.Info("Detected authentication mode", "mode", authMode, "cluster", rayCluster.Name)
 
 	// Handle authentication based on detected mode
 	// Both OAuth and OIDC modes use the same OIDC configuration (kube-rbac-proxy + HTTPRoute)
-	var err error
 	switch authMode {
 	case utils.ModeIntegratedOAuth:
 		logger.Info("Handling Integrated OAuth with OIDC configuration", "cluster", rayCluster.Name)
@@ -214,6 +226,11 @@ func (r *AuthenticationController) ensureOIDCResources(ctx context.Context, clus
 		return fmt.Errorf("failed to ensure ConfigMap: %w", err)
 	}
 
+	// Grant the users and groups of the dashboard access policy access to the dashboard
+	if err := r.ensureDashboardAccessRBAC(ctx, cluster, logger); err != nil {
+		return fmt.Errorf("failed to ensure dashboard access RBAC: %w", err)
+	}
+
 	return nil
 }
 
@@ -242,6 +259,11 @@ func (r *AuthenticationController) cleanupOIDCResources(ctx context.Context, clu
 		}
 	}
 
+	// Remove the Role and RoleBinding of the dashboard access policy
+	if err := r.deleteDashboardAccessRBAC(ctx, cluster, logger); err != nil {
+		logger.Info("Failed to delete dashboard access RBAC", "error", err)
+	}
+
 	// Remove service account
 	sa := &corev1.ServiceAccount{}
 	saName := namer.ServiceAccountName(authMode)
@@ -262,6 +284,7 @@ func (r *AuthenticationController) ensureHttpRoute(ctx context.Context, cluster
 		return err
 	}
 
+	gateway := getDashboardGateway(cluster, r.options.DashboardGateway)
 	httpRoute := &gatewayv1.HTTPRoute{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      cluster.Name,
@@ -278,32 +301,40 @@ func (r *AuthenticationController) ensureHttpRoute(ctx context.Context, cluster
 		// Helper variables for pointer fields
 		group := gatewayv1.Group("gateway.networking.k8s.io")
 		kind := gatewayv1.Kind("Gateway")
-		gatewayName := gatewayv1.ObjectName("data-science-gateway")
-		namespace := gatewayv1.Namespace("openshift-ingress")
+		gatewayName := gatewayv1.ObjectName(gateway.Name)
+		namespace := gatewayv1.Namespace(gateway.Namespace)
 		serviceGroup := gatewayv1.Group("")
 		serviceKind := gatewayv1.Kind("Service")
 		weight := int32(1)
 		pathExact := gatewayv1.PathMatchExact
 		pathPrefix := gatewayv1.PathMatchPathPrefix
-		port := gatewayv1.PortNumber(8265)
+		port := gatewayv1.PortNumber(utils.DefaultDashboardPort)
 		pathValue := "/"
-		prefixValue := fmt.Sprintf("/ray/%s/%s", cluster.Namespace, cluster.Name)
+		prefixValue := dashboardPath(cluster, gateway)
+
+		parentRef := gatewayv1.ParentReference{
+			Group:     &group,
+			Kind:      &kind,
+			Name:      gatewayName,
+			Namespace: &namespace,
+		}
+		if gateway.SectionName != "" {
+			parentRef.SectionName = ptr.To(gatewayv1.SectionName(gateway.SectionName))
+		}
+		var hostnames []gatewayv1.Hostname
+		if gateway.Hostname != "" {
+			hostnames = []gatewayv1.Hostname{gatewayv1.Hostname(gateway.Hostname)}
+		}
 
 		// Update the HTTPRoute spec
 		httpRoute.Spec = gatewayv1.HTTPRouteSpec{
 			CommonRouteSpec: gatewayv1.CommonRouteSpec{
-				ParentRefs: []gatewayv1.ParentReference{
-					{
-						Group:     &group,
-						Kind:      &kind,
-						Name:      gatewayName,
-						Namespace: &namespace,
-					},
-				},
+				ParentRefs: []gatewayv1.ParentReference{parentRef},
 			},
+			Hostnames: hostnames,
 			Rules: []gatewayv1.HTTPRouteRule{
 				// Rule 1: Exact match for root path - redirect to #/
-				// This handles the case when users access /ray/{namespace}/{cluster} without the trailing hash
+				// This handles the case when users access the dashboard path without the trailing hash
 				{
 					Matches: []gatewayv1.HTTPRouteMatch{
 						{
@@ -378,6 +409,53 @@ func (r *AuthenticationController) ensureHttpRoute(ctx context.Context, cluster
 	return nil
 }
 
+// getDashboardGateway returns the parent Gateway, hostname, and path template of the HTTPRoute of the Ray dashboard of
+// the RayCluster, i.e. its ray.io/dashboard-* annotations, else the dashboardGateway configuration of the operator,
+// else the defaults
+func getDashboardGateway(cluster *rayv1.RayCluster, config *configapi.DashboardGatewayConfig) configapi.DashboardGatewayConfig {
+	gateway := configapi.DashboardGatewayConfig{}
+	if config != nil {
+		gateway = *config
+	}
+	for key, field := range map[string]*string{
+		utils.RayDashboardGatewayNameAnnotationKey:        &gateway.Name,
+		utils.RayDashboardGatewayNamespaceAnnotationKey:   &gateway.Namespace,
+		utils.RayDashboardGatewaySectionNameAnnotationKey: &gateway.SectionName,
+		utils.RayDashboardHostnameAnnotationKey:           &gateway.Hostname,
+		utils.RayDashboardPathTemplateAnnotationKey:       &gateway.PathTemplate,
+	} {
+		if value, ok := cluster.Annotations[key]; ok {
+			*field = value
+		}
+	}
+	if gateway.Name == "" {
+		gateway.Name = utils.DefaultDashboardGatewayName
+	}
+	if gateway.Namespace == "" {
+		gateway.Namespace = utils.DefaultDashboardGatewayNamespace
+	}
+	if gateway.PathTemplate == "" {
+		gateway.PathTemplate = utils.DefaultDashboardPathTemplate
+	}
+	return gateway
+}
+
+// dashboardPath returns the path prefix of the Ray dashboard of the RayCluster
+func dashboardPath(cluster *rayv1.RayCluster, gateway configapi.DashboardGatewayConfig) string {
+	return strings.NewReplacer("{namespace}", cluster.Namespace, "{name}", cluster.Name).Replace(gateway.PathTemplate)
+}
+
+// dashboardURL returns the URL of the Ray dashboard of the RayCluster through the Gateway, or only its path if the
+// hostname of the Gateway is not configured
+func dashboardURL(cluster *rayv1.RayCluster, config *configapi.DashboardGatewayConfig) string {
+	gateway := getDashboardGateway(cluster, config)
+	path := dashboardPath(cluster, gateway)
+	if gateway.Hostname == "" {
+		return path
+	}
+	return "https://" + gateway.Hostname + path
+}
+
 func (r *AuthenticationController) ensureOIDCConfigMap(ctx context.Context, cluster *rayv1.RayCluster, logger logr.Logger) error {
 	namer := utils.NewResourceNamer(cluster)
 	configMap := &corev1.ConfigMap{
@@ -394,17 +472,7 @@ func (r *AuthenticationController) ensureOIDCConfigMap(ctx context.Context, clus
 		}
 
 		// Build ConfigMap data dynamically
-		configYAML := fmt.Sprintf(`
-authorization:
-  resourceAttributes:
-    # For an incoming request, the proxy will check if the user
-    # has the "get" verb on the "services" resource.
-    verb: "get"
-    resource: "services"
-    # The API group and resource name should match the target Service.
-    apiGroup: ""
-    resourceName: "%s"
-`, cluster.Name+"-head-svc")
+		configYAML := kubeRBACProxyConfig(cluster)
 
 		// Set labels and data
 		if configMap.Labels == nil {
@@ -430,6 +498,138 @@ authorization:
 	return nil
 }
 
+// kubeRBACProxyConfig renders the kube-rbac-proxy config, which checks the dashboard access policy of the RayCluster
+// if it has one
+func kubeRBACProxyConfig(cluster *rayv1.RayCluster) string {
+	attributes := utils.GetDashboardAccessAttributes(cluster)
+	if attributes == nil {
+		return fmt.Sprintf(`
+authorization:
+  resourceAttributes:
+    # For an incoming request, the proxy will check if the user
+    # has the "get" verb on the "services" resource.
+    verb: "get"
+    resource: "services"
+    # The API group and resource name should match the target Service.
+    apiGroup: ""
+    resourceName: "%s"
+`, cluster.Name+"-head-svc")
+	}
+
+	configYAML := fmt.Sprintf(`
+authorization:
+  resourceAttributes:
+    # For an incoming request, the proxy will check the dashboard access policy of the RayCluster.
+    namespace: %q
+    verb: %q
+    resource: %q
+    apiGroup: %q
+`, attributes.Namespace, attributes.Verb, attributes.Resource, attributes.Group)
+	if attributes.Subresource != "" {
+		configYAML += fmt.Sprintf("    subresource: %q\n", attributes.Subresource)
+	}
+	if attributes.Name != "" {
+		configYAML += fmt.Sprintf("    resourceName: %q\n", attributes.Name)
+	}
+	return configYAML
+}
+
+// ensureDashboardAccessRBAC creates or updates the Role and RoleBinding that grant the users and groups of the
+// dashboard access policy the "get" verb on the rayclusters/dashboard subresource of the RayCluster, and deletes
+// them if the policy lists no users or groups
+func (r *AuthenticationController) ensureDashboardAccessRBAC(ctx context.Context, cluster *rayv1.RayCluster, logger logr.Logger) error {
+	if !utils.HasDashboardAccessSubjects(cluster) {
+		return r.deleteDashboardAccessRBAC(ctx, cluster, logger)
+	}
+
+	name := utils.NewResourceNamer(cluster).DashboardAccessRoleName()
+	role := &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: cluster.Namespace,
+		},
+	}
+	opResult, err := controllerutil.CreateOrUpdate(ctx, r.Client, role, func() error {
+		if err := controllerutil.SetControllerReference(cluster, role, r.Scheme); err != nil {
+			return err
+		}
+		role.Rules = []rbacv1.PolicyRule{
+			{
+				APIGroups:     []string{rayv1.GroupVersion.Group},
+				Resources:     []string{utils.DashboardAccessResource + "/" + utils.DashboardAccessSubresource},
+				ResourceNames: []string{cluster.Name},
+				Verbs:         []string{utils.DefaultDashboardAccessVerb},
+			},
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create or update dashboard access Role: %w", err)
+	}
+	if opResult != controllerutil.OperationResultNone {
+		logger.Info("Dashboard access Role reconciled", "name", name, "operation", opResult)
+	}
+
+	policy := utils.GetDashboardAccessPolicy(cluster)
+	subjects := make([]rbacv1.Subject, 0, len(policy.Users)+len(policy.Groups))
+	for _, user := range policy.Users {
+		subjects = append(subjects, rbacv1.Subject{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: user})
+	}
+	for _, group := range policy.Groups {
+		subjects = append(subjects, rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: group})
+	}
+	roleBinding := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: cluster.Namespace,
+		},
+	}
+	opResult, err = controllerutil.CreateOrUpdate(ctx, r.Client, roleBinding, func() error {
+		if err := controllerutil.SetControllerReference(cluster, roleBinding, r.Scheme); err != nil {
+			return err
+		}
+		// The roleRef of a RoleBinding is immutable, so it is only set when the RoleBinding is created
+		if roleBinding.CreationTimestamp.IsZero() {
+			roleBinding.RoleRef = rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: name}
+		}
+		roleBinding.Subjects = subjects
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create or update dashboard access RoleBinding: %w", err)
+	}
+	if opResult != controllerutil.OperationResultNone {
+		logger.Info("Dashboard access RoleBinding reconciled", "name", name, "operation", opResult)
+	}
+
+	return nil
+}
+
+// deleteDashboardAccessRBAC deletes the Role and RoleBinding of the dashboard access policy of the RayCluster
+func (r *AuthenticationController) deleteDashboardAccessRBAC(ctx context.Context, cluster *rayv1.RayCluster, logger logr.Logger) error {
+	name := utils.NewResourceNamer(cluster).DashboardAccessRoleName()
+	for _, obj := range []client.Object{
+		&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: cluster.Namespace}},
+		&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: cluster.Namespace}},
+	} {
+		// Look the objects up in the cache first, since this runs on every reconciliation without a policy
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if err := r.Delete(ctx, obj); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		logger.Info("Deleted dashboard access RBAC", "kind", fmt.Sprintf("%T", obj), "name", name)
+	}
+	return nil
+}
+
 // ensureServiceAccount creates or updates the service account for authentication (used for both OIDC and OAuth modes)
 func (r *AuthenticationController) ensureServiceAccount(ctx context.Context, cluster *rayv1.RayCluster, authMode utils.AuthenticationMode, logger logr.Logger) error {
 	namer := utils.NewResourceNamer(cluster)
@@ -551,9 +751,9 @@ func generateSelfSignedCert(cluster *rayv1.RayCluster) ([]byte, []byte, error) {
 
 // GetOAuthProxySidecar returns the OAuth proxy sidecar container configuration
 // This can be used by the RayCluster controller to inject the sidecar
-func GetOAuthProxySidecar(cluster *rayv1.RayCluster) corev1.Container {
+func GetOAuthProxySidecar(cluster *rayv1.RayCluster, config *configapi.AuthProxyConfig) corev1.Container {
 	namer := utils.NewResourceNamer(cluster)
-	return corev1.Container{
+	container := corev1.Container{
 		Name:            oauthProxyContainerName,
 		Image:           oauthProxyImage,
 		ImagePullPolicy: corev1.PullIfNotPresent,
@@ -568,7 +768,7 @@ func GetOAuthProxySidecar(cluster *rayv1.RayCluster) corev1.Container {
 			"--tls-cert=/etc/tls/private/tls.crt",
 			"--tls-key=/etc/tls/private/tls.key",
 			"--cookie-secret=$(COOKIE_SECRET)",
-			fmt.Sprintf("--openshift-delegate-urls=%s", utils.FormatOAuthDelegateURLs(cluster.Namespace)),
+			fmt.Sprintf("--openshift-delegate-urls=%s", utils.FormatDashboardDelegateURLs(cluster)),
 			"--skip-provider-button",
 		},
 		Env: []corev1.EnvVar{
@@ -602,6 +802,14 @@ func GetOAuthProxySidecar(cluster *rayv1.RayCluster) corev1.Container {
 			FailureThreshold:    3,
 		}),
 	}
+	// Check the dashboard access policy when a user logs in, not only for requests with a bearer token
+	if sar := utils.FormatDashboardSAR(cluster); sar != "" {
+		container.Args = append(container.Args, fmt.Sprintf("--openshift-sar=%s", sar))
+	}
+	if config != nil {
+		applyAuthProxyConfig(&container, config.OAuthProxyImage, config)
+	}
+	return container
 }
 
 // GetOAuthProxyVolumes returns the volumes needed for OAuth proxy sidecar
@@ -613,28 +821,78 @@ func GetOAuthProxyVolumes(cluster *rayv1.RayCluster) []corev1.Volume {
 	}
 }
 
-func GetOIDCProxySidecar(cluster *rayv1.RayCluster) corev1.Container {
+// GetOIDCProxySidecar returns the kube-rbac-proxy sidecar container configuration
+func GetOIDCProxySidecar(cluster *rayv1.RayCluster, config *configapi.AuthProxyConfig) corev1.Container {
+	return buildKubeRBACProxySidecar(cluster, config, oidcProxyContainerName, oidcProxyPortName, authProxyPort, utils.DefaultDashboardPort)
+}
+
+// GetServeProxySidecar returns the kube-rbac-proxy sidecar in front of the Ray Serve HTTP endpoint
+func GetServeProxySidecar(cluster *rayv1.RayCluster, config *configapi.AuthProxyConfig) corev1.Container {
+	upstreamPort := headContainerPort(cluster, utils.ServingPortName, utils.DefaultServingPort)
+	return buildKubeRBACProxySidecar(cluster, config, serveProxyContainerName, utils.ServeAuthProxyPortName, utils.DefaultServeAuthProxyPort, upstreamPort)
+}
+
+// GetClientProxySidecar returns the kube-rbac-proxy sidecar that terminates TLS in front of the Ray client port
+// The Ray client server speaks gRPC without TLS, so the proxy forwards HTTP/2 in cleartext
+func GetClientProxySidecar(cluster *rayv1.RayCluster, config *configapi.AuthProxyConfig) corev1.Container {
+	upstreamPort := headContainerPort(cluster, utils.ClientPortName, utils.DefaultClientPort)
+	return buildKubeRBACProxySidecar(cluster, config, clientProxyContainerName, utils.ClientAuthProxyPortName, utils.DefaultClientAuthProxyPort, upstreamPort,
+		"--upstream-force-h2c=true")
+}
+
+// buildKubeRBACProxySidecar returns a kube-rbac-proxy sidecar that listens on port and forwards the authorized
+// requests to upstreamPort of the Ray container. Every kube-rbac-proxy sidecar of the head Pod checks the access
+// described by the same config file.
+func buildKubeRBACProxySidecar(cluster *rayv1.RayCluster, config *configapi.AuthProxyConfig, name string, portName string, port int32, upstreamPort int, args ...string) corev1.Container {
 	namer := utils.NewResourceNamer(cluster)
 	configMapName := namer.ConfigMapName()
-	return corev1.Container{
-		Name:            oidcProxyContainerName,
+	container := corev1.Container{
+		Name:            name,
 		Image:           oidcProxyContainerImage,
 		ImagePullPolicy: corev1.PullIfNotPresent,
 		Ports: []corev1.ContainerPort{
-			utils.CreateContainerPort(authProxyPort, oidcProxyPortName),
+			utils.CreateContainerPort(port, portName),
 		},
-		Args: []string{
-			fmt.Sprintf("--secure-listen-address=0.0.0.0:%d", authProxyPort),
-			"--upstream=http://127.0.0.1:8265/",
+		Args: append([]string{
+			fmt.Sprintf("--secure-listen-address=0.0.0.0:%d", port),
+			fmt.Sprintf("--upstream=http://127.0.0.1:%d/", upstreamPort),
 			"--config-file=/etc/kube-rbac-proxy/config.yaml",
 			"--logtostderr=true",
-		},
+		}, args...),
 		VolumeMounts: []corev1.VolumeMount{
 			utils.CreateVolumeMount(configMapName, "/etc/kube-rbac-proxy/", true),
 		},
 		// Add resource limits to prevent excessive resource usage
 		Resources: utils.StandardProxyResources(),
 	}
+	if config != nil {
+		applyAuthProxyConfig(&container, config.KubeRBACProxyImage, config)
+	}
+	return container
+}
+
+// headContainerPort returns the port named portName of the Ray container of the head Pod, else defaultPort
+func headContainerPort(cluster *rayv1.RayCluster, portName string, defaultPort int) int {
+	containers := cluster.Spec.HeadGroupSpec.Template.Spec.Containers
+	if len(containers) <= utils.RayContainerIndex {
+		return defaultPort
+	}
+	return utils.FindContainerPort(&containers[utils.RayContainerIndex], portName, defaultPort)
+}
+
+// applyAuthProxyConfig overrides the image, pull policy, resources, and args of an auth proxy sidecar with the
+// authProxy configuration of the operator
+func applyAuthProxyConfig(container *corev1.Container, image string, config *configapi.AuthProxyConfig) {
+	if image != "" {
+		container.Image = image
+	}
+	if config.ImagePullPolicy != "" {
+		container.ImagePullPolicy = config.ImagePullPolicy
+	}
+	if config.Resources != nil {
+		container.Resources = *config.Resources.DeepCopy()
+	}
+	container.Args = append(container.Args, config.ExtraArgs...)
 }
 
 func GetOIDCProxyVolumes(cluster *rayv1.RayCluster) []corev1.Volume {
@@ -653,52 +911,55 @@ func (r *AuthenticationController) SetupWithManager(mgr ctrl.Manager) error {
 		predicate.AnnotationChangedPredicate{},
 	)
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		// PRIMARY: Watch RayClusters
 		For(&rayv1.RayCluster{}, builder.WithPredicates(rayClusterPredicate)).
 		// OWNED: Watch resources owned by RayClusters
 		Owns(&corev1.ServiceAccount{}).
 		Owns(&corev1.Service{}).
-		Owns(&routev1.Route{}).
-		// // SECONDARY: Watch cluster-wide auth config and map to all RayClusters
-		// Watches(
-		// 	&configv1.Authentication{},
-		// 	handler.EnqueueRequestsFromMapFunc(r.mapAuthResourceToRayClusters),
-		// ).
-		// Watches(
-		// 	&configv1.OAuth{},
-		// 	handler.EnqueueRequestsFromMapFunc(r.mapAuthResourceToRayClusters),
-		// ).
+		Owns(&rbacv1.Role{}).
+		Owns(&rbacv1.RoleBinding{}).
+		Owns(&routev1.Route{})
+	if r.options.IsOpenShift {
+		// SECONDARY: Watch the cluster-wide auth config and map it to the RayClusters with an auth proxy
+		b = b.Watches(&configv1.Authentication{}, handler.EnqueueRequestsFromMapFunc(mapAuthResourceToRayClusters(r)))
+	}
+	return b.
 		Named("authentication").
 		Complete(r)
 }
 
-// mapAuthResourceToRayClusters maps cluster-wide auth config changes to all RayClusters
-// This ensures all clusters are re-evaluated when authentication mode changes
-func (r *AuthenticationController) mapAuthResourceToRayClusters(ctx context.Context, obj client.Object) []reconcile.Request {
-	logger := ctrl.LoggerFrom(ctx)
-
-	// List all RayClusters in all namespaces
-	rayClusterList := &rayv1.RayClusterList{}
-	if err := r.List(ctx, rayClusterList); err != nil {
-		logger.Error(err, "Failed to list RayClusters for authentication config mapping")
-		return []reconcile.Request{}
-	}
-
-	// Create reconcile requests for all clusters
-	requests := make([]reconcile.Request, 0)
-	for _, cluster := range rayClusterList.Items {
-		requests = append(requests, reconcile.Request{
-			NamespacedName: types.NamespacedName{
-				Name:      cluster.Name,
-				Namespace: cluster.Namespace,
-			},
-		})
-	}
+// mapAuthResourceToRayClusters maps cluster-wide auth config changes to the RayClusters with an auth proxy
+// This ensures the affected clusters are re-evaluated when authentication mode changes
+func mapAuthResourceToRayClusters(c client.Reader) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		logger := ctrl.LoggerFrom(ctx)
+
+		// List all RayClusters in all namespaces
+		rayClusterList := &rayv1.RayClusterList{}
+		if err := c.List(ctx, rayClusterList); err != nil {
+			logger.Error(err, "Failed to list RayClusters for authentication config mapping")
+			return []reconcile.Request{}
+		}
 
-	logger.Info("Mapping authentication config change to RayClusters",
-		"authResource", obj.GetName(),
-		"clusters", len(requests))
+		// Create reconcile requests for the clusters whose auth proxy depends on the authentication mode
+		requests := make([]reconcile.Request, 0)
+		for _, cluster := range rayClusterList.Items {
+			if !utils.IsAuthProxyEnabled(&cluster) {
+				continue
+			}
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      cluster.Name,
+					Namespace: cluster.Namespace,
+				},
+			})
+		}
+
+		logger.Info("Mapping authentication config change to RayClusters",
+			"authResource", obj.GetName(),
+			"clusters", len(requests))
 
-	return requests
+		return requests
+	}
 }
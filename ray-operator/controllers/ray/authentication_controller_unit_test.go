@@ -24,7 +24,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -35,6 +37,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	configapi "github.com/ray-project/kuberay/ray-operator/apis/config/v1alpha1"
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
 )
@@ -43,6 +46,7 @@ import (
 func setupScheme() *runtime.Scheme {
 	s := runtime.NewScheme()
 	_ = corev1.AddToScheme(s)
+	_ = rbacv1.AddToScheme(s)
 	_ = rayv1.AddToScheme(s)
 	_ = configv1.AddToScheme(s)
 	_ = routev1.AddToScheme(s)
@@ -66,7 +70,7 @@ func TestDetectAuthenticationMode(t *testing.T) {
 			expected: utils.ModeIntegratedOAuth,
 		},
 		{
-			name: "OpenShift - returns ModeIntegratedOAuth (OIDC detection is commented out)",
+			name: "OpenShift with OIDC authentication type - returns ModeOIDC",
 			options: RayClusterReconcilerOptions{
 				IsOpenShift: true,
 			},
@@ -76,13 +80,14 @@ func TestDetectAuthenticationMode(t *testing.T) {
 						Name: "cluster",
 					},
 					Spec: configv1.AuthenticationSpec{
+						Type: configv1.AuthenticationTypeOIDC,
 						OIDCProviders: []configv1.OIDCProvider{
 							{Name: "test-oidc"},
 						},
 					},
 				},
 			},
-			expected: utils.ModeIntegratedOAuth, // Changed: OIDC detection is disabled
+			expected: utils.ModeOIDC,
 		},
 		{
 			name: "OpenShift with OAuth identity providers - returns ModeIntegratedOAuth",
@@ -142,8 +147,11 @@ func TestDetectAuthenticationMode(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := clientFake.NewClientBuilder().WithScheme(setupScheme()).WithRuntimeObjects(tc.objects...).Build()
+
 			// Test
-			result := utils.DetectAuthenticationMode(tc.options.IsOpenShift)
+			result, err := utils.DetectAuthenticationMode(context.Background(), fakeClient, tc.options.IsOpenShift)
+			require.NoError(t, err)
 			assert.Equal(t, tc.expected, result)
 		})
 	}
@@ -451,6 +459,100 @@ func TestEnsureOIDCConfigMap(t *testing.T) {
 	}
 }
 
+func TestKubeRBACProxyConfig(t *testing.T) {
+	cluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cluster",
+			Namespace: "default",
+		},
+	}
+	// Without a dashboard access policy, the proxy checks access to the head service.
+	assert.Contains(t, kubeRBACProxyConfig(cluster), `resourceName: "test-cluster-head-svc"`)
+
+	cluster.Spec.SecurityOptions = &rayv1.SecurityOptions{
+		DashboardAccessPolicy: &rayv1.DashboardAccessPolicy{Groups: []string{"ml-team"}},
+	}
+	config := kubeRBACProxyConfig(cluster)
+	assert.Contains(t, config, `namespace: "default"`)
+	assert.Contains(t, config, `verb: "get"`)
+	assert.Contains(t, config, `resource: "rayclusters"`)
+	assert.Contains(t, config, `apiGroup: "ray.io"`)
+	assert.Contains(t, config, `subresource: "dashboard"`)
+	assert.Contains(t, config, `resourceName: "test-cluster"`)
+
+	cluster.Spec.SecurityOptions.DashboardAccessPolicy = &rayv1.DashboardAccessPolicy{
+		ResourceAttributes: &rayv1.DashboardResourceAttributes{Verb: "list", Group: "apps", Resource: "deployments"},
+	}
+	config = kubeRBACProxyConfig(cluster)
+	assert.Contains(t, config, `verb: "list"`)
+	assert.Contains(t, config, `resource: "deployments"`)
+	assert.Contains(t, config, `apiGroup: "apps"`)
+	assert.NotContains(t, config, "subresource")
+	assert.NotContains(t, config, "resourceName")
+}
+
+func TestEnsureDashboardAccessRBAC(t *testing.T) {
+	ctx := context.Background()
+	cluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cluster",
+			Namespace: "default",
+			UID:       "test-uid",
+		},
+		Spec: rayv1.RayClusterSpec{
+			SecurityOptions: &rayv1.SecurityOptions{
+				DashboardAccessPolicy: &rayv1.DashboardAccessPolicy{
+					Users:  []string{"alice"},
+					Groups: []string{"ml-team"},
+				},
+			},
+		},
+	}
+
+	s := setupScheme()
+	fakeClient := clientFake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(cluster).Build()
+	controller := &AuthenticationController{
+		Client:   fakeClient,
+		Scheme:   s,
+		Recorder: record.NewFakeRecorder(10),
+	}
+	key := types.NamespacedName{Name: "test-cluster-dashboard-access", Namespace: "default"}
+
+	// The Role grants access to the dashboard subresource of the RayCluster only.
+	require.NoError(t, controller.ensureDashboardAccessRBAC(ctx, cluster, ctrl.Log))
+	role := &rbacv1.Role{}
+	require.NoError(t, fakeClient.Get(ctx, key, role))
+	assert.Equal(t, []rbacv1.PolicyRule{{
+		APIGroups:     []string{"ray.io"},
+		Resources:     []string{"rayclusters/dashboard"},
+		ResourceNames: []string{"test-cluster"},
+		Verbs:         []string{"get"},
+	}}, role.Rules)
+	assert.True(t, metav1.IsControlledBy(role, cluster))
+
+	roleBinding := &rbacv1.RoleBinding{}
+	require.NoError(t, fakeClient.Get(ctx, key, roleBinding))
+	assert.Equal(t, rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: "test-cluster-dashboard-access"}, roleBinding.RoleRef)
+	assert.Equal(t, []rbacv1.Subject{
+		{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "alice"},
+		{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "ml-team"},
+	}, roleBinding.Subjects)
+
+	// The subjects of the RoleBinding follow the policy.
+	cluster.Spec.SecurityOptions.DashboardAccessPolicy.Users = nil
+	require.NoError(t, controller.ensureDashboardAccessRBAC(ctx, cluster, ctrl.Log))
+	require.NoError(t, fakeClient.Get(ctx, key, roleBinding))
+	assert.Equal(t, []rbacv1.Subject{
+		{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "ml-team"},
+	}, roleBinding.Subjects)
+
+	// Both are deleted when the policy lists no users or groups.
+	cluster.Spec.SecurityOptions.DashboardAccessPolicy = nil
+	require.NoError(t, controller.ensureDashboardAccessRBAC(ctx, cluster, ctrl.Log))
+	assert.True(t, errors.IsNotFound(fakeClient.Get(ctx, key, &rbacv1.Role{})))
+	assert.True(t, errors.IsNotFound(fakeClient.Get(ctx, key, &rbacv1.RoleBinding{})))
+}
+
 func TestEnsureHttpRoute(t *testing.T) {
 	tests := []struct {
 		existingHttpRoute *gatewayv1.HTTPRoute
@@ -537,10 +639,89 @@ func TestEnsureHttpRoute(t *testing.T) {
 			assert.Equal(t, gatewayv1.PathMatchPathPrefix, *route.Spec.Rules[1].Matches[0].Path.Type)
 			assert.Equal(t, "/ray/default/test-cluster", *route.Spec.Rules[1].Matches[0].Path.Value)
 			assert.NotEmpty(t, route.Spec.Rules[1].BackendRefs)
+
+			// The default parent Gateway
+			require.Len(t, route.Spec.ParentRefs, 1)
+			assert.Equal(t, gatewayv1.ObjectName("data-science-gateway"), route.Spec.ParentRefs[0].Name)
+			assert.Equal(t, gatewayv1.Namespace("openshift-ingress"), *route.Spec.ParentRefs[0].Namespace)
+			assert.Nil(t, route.Spec.ParentRefs[0].SectionName)
+			assert.Empty(t, route.Spec.Hostnames)
 		})
 	}
 }
 
+func TestEnsureHttpRouteDashboardGateway(t *testing.T) {
+	ctx := context.Background()
+	cluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cluster",
+			Namespace: "default",
+			UID:       "test-uid",
+			Annotations: map[string]string{
+				utils.RayDashboardGatewaySectionNameAnnotationKey: "https-ml",
+				utils.RayDashboardPathTemplateAnnotationKey:       "/dashboards/{namespace}/{name}",
+			},
+		},
+		Spec: rayv1.RayClusterSpec{
+			HeadGroupSpec: rayv1.HeadGroupSpec{
+				ServiceType: corev1.ServiceTypeClusterIP,
+			},
+		},
+	}
+
+	s := setupScheme()
+	fakeClient := clientFake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(cluster).Build()
+	controller := &AuthenticationController{
+		Client:   fakeClient,
+		Scheme:   s,
+		Recorder: record.NewFakeRecorder(10),
+		options: RayClusterReconcilerOptions{
+			DashboardGateway: &configapi.DashboardGatewayConfig{
+				Name:         "ml-gateway",
+				Namespace:    "gateways",
+				Hostname:     "ray.example.com",
+				PathTemplate: "/ray/{namespace}/{name}/dashboard",
+			},
+		},
+	}
+	require.NoError(t, controller.ensureHttpRoute(ctx, cluster, ctrl.Log))
+
+	route := &gatewayv1.HTTPRoute{}
+	require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: "test-cluster", Namespace: "default"}, route))
+
+	// The operator configuration sets the Gateway and hostname, and the annotations override the rest.
+	require.Len(t, route.Spec.ParentRefs, 1)
+	assert.Equal(t, gatewayv1.ObjectName("ml-gateway"), route.Spec.ParentRefs[0].Name)
+	assert.Equal(t, gatewayv1.Namespace("gateways"), *route.Spec.ParentRefs[0].Namespace)
+	assert.Equal(t, gatewayv1.SectionName("https-ml"), *route.Spec.ParentRefs[0].SectionName)
+	assert.Equal(t, []gatewayv1.Hostname{"ray.example.com"}, route.Spec.Hostnames)
+	assert.Equal(t, "/dashboards/default/test-cluster", *route.Spec.Rules[0].Matches[0].Path.Value)
+	assert.Equal(t, "/dashboards/default/test-cluster/#/", *route.Spec.Rules[0].Filters[0].RequestRedirect.Path.ReplaceFullPath)
+	assert.Equal(t, "/dashboards/default/test-cluster", *route.Spec.Rules[1].Matches[0].Path.Value)
+}
+
+func TestDashboardURL(t *testing.T) {
+	cluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cluster",
+			Namespace: "default",
+		},
+	}
+	// Without a hostname, only the path of the dashboard is known.
+	assert.Equal(t, "/ray/default/test-cluster", dashboardURL(cluster, nil))
+
+	config := &configapi.DashboardGatewayConfig{Hostname: "ray.example.com"}
+	assert.Equal(t, "https://ray.example.com/ray/default/test-cluster", dashboardURL(cluster, config))
+
+	cluster.Annotations = map[string]string{
+		utils.RayDashboardHostnameAnnotationKey:     "ml.example.com",
+		utils.RayDashboardPathTemplateAnnotationKey: "/{namespace}-{name}",
+	}
+	assert.Equal(t, "https://ml.example.com/default-test-cluster", dashboardURL(cluster, config))
+	// The configuration of the operator is not modified by the annotations.
+	assert.Equal(t, &configapi.DashboardGatewayConfig{Hostname: "ray.example.com"}, config)
+}
+
 func TestMapAuthResourceToRayClusters(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -559,6 +740,9 @@ func TestMapAuthResourceToRayClusters(t *testing.T) {
 					ObjectMeta: metav1.ObjectMeta{
 						Name:      "cluster1",
 						Namespace: "default",
+						Annotations: map[string]string{
+							utils.EnableSecureTrustedNetworkAnnotationKey: "true",
+						},
 					},
 				},
 			},
@@ -571,23 +755,44 @@ func TestMapAuthResourceToRayClusters(t *testing.T) {
 					ObjectMeta: metav1.ObjectMeta{
 						Name:      "cluster1",
 						Namespace: "default",
+						Annotations: map[string]string{
+							utils.EnableSecureTrustedNetworkAnnotationKey: "true",
+						},
 					},
 				},
 				&rayv1.RayCluster{
 					ObjectMeta: metav1.ObjectMeta{
 						Name:      "cluster2",
 						Namespace: "default",
+						Annotations: map[string]string{
+							utils.EnableSecureTrustedNetworkAnnotationKey: "true",
+						},
 					},
 				},
 				&rayv1.RayCluster{
 					ObjectMeta: metav1.ObjectMeta{
 						Name:      "cluster3",
 						Namespace: "test-namespace",
+						Annotations: map[string]string{
+							utils.EnableSecureTrustedNetworkAnnotationKey: "true",
+						},
 					},
 				},
 			},
 			expectedRequests: 3,
 		},
+		{
+			name: "Cluster without auth proxy - should not return a request",
+			clusters: []runtime.Object{
+				&rayv1.RayCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "cluster1",
+						Namespace: "default",
+					},
+				},
+			},
+			expectedRequests: 0,
+		},
 	}
 
 	for _, tc := range tests {
@@ -615,7 +820,7 @@ func TestMapAuthResourceToRayClusters(t *testing.T) {
 			}
 
 			// Execute
-			requests := controller.mapAuthResourceToRayClusters(ctx, authResource)
+			requests := mapAuthResourceToRayClusters(controller)(ctx, authResource)
 
 			// Verify
 			assert.Len(t, requests, tc.expectedRequests)
@@ -644,7 +849,7 @@ func TestGetOAuthProxySidecar(t *testing.T) {
 		},
 	}
 
-	container := GetOAuthProxySidecar(cluster)
+	container := GetOAuthProxySidecar(cluster, nil)
 
 	assert.Equal(t, oauthProxyContainerName, container.Name)
 	assert.Equal(t, oauthProxyImage, container.Image)
@@ -664,6 +869,22 @@ func TestGetOAuthProxySidecar(t *testing.T) {
 	// Verify probes are configured
 	assert.NotNil(t, container.LivenessProbe)
 	assert.NotNil(t, container.ReadinessProbe)
+
+	// Without a dashboard access policy, the proxy does not check a SubjectAccessReview on login
+	for _, arg := range container.Args {
+		assert.NotContains(t, arg, "--openshift-sar")
+	}
+
+	// With a dashboard access policy, the proxy checks it on login and for bearer tokens
+	cluster.Spec.SecurityOptions = &rayv1.SecurityOptions{
+		DashboardAccessPolicy: &rayv1.DashboardAccessPolicy{
+			ResourceAttributes: &rayv1.DashboardResourceAttributes{Resource: "services", Name: "ml-dashboard"},
+		},
+	}
+	container = GetOAuthProxySidecar(cluster, nil)
+	sar := `{"namespace":"default","verb":"get","resource":"services","name":"ml-dashboard"}`
+	assert.Contains(t, container.Args, "--openshift-delegate-urls="+`{"/":`+sar+`}`)
+	assert.Contains(t, container.Args, "--openshift-sar="+sar)
 }
 
 func TestGetOAuthProxyVolumes(t *testing.T) {
@@ -696,7 +917,7 @@ func TestGetOIDCProxySidecar(t *testing.T) {
 		},
 	}
 
-	container := GetOIDCProxySidecar(cluster)
+	container := GetOIDCProxySidecar(cluster, nil)
 
 	assert.Equal(t, oidcProxyContainerName, container.Name)
 	assert.Equal(t, oidcProxyContainerImage, container.Image)
@@ -712,6 +933,78 @@ func TestGetOIDCProxySidecar(t *testing.T) {
 	assert.Equal(t, "kube-rbac-proxy-config-"+cluster.Name, container.VolumeMounts[0].Name)
 }
 
+func TestGetServeAndClientProxySidecars(t *testing.T) {
+	cluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cluster",
+			Namespace: "default",
+		},
+		Spec: rayv1.RayClusterSpec{
+			HeadGroupSpec: rayv1.HeadGroupSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{
+							Name:  "ray-head",
+							Ports: []corev1.ContainerPort{{Name: utils.ServingPortName, ContainerPort: 9000}},
+						}},
+					},
+				},
+			},
+		},
+	}
+
+	// The Serve listener forwards to the Serve port of the Ray container.
+	serveSidecar := GetServeProxySidecar(cluster, nil)
+	assert.Equal(t, serveProxyContainerName, serveSidecar.Name)
+	assert.Equal(t, int32(utils.DefaultServeAuthProxyPort), serveSidecar.Ports[0].ContainerPort)
+	assert.Contains(t, serveSidecar.Args, "--secure-listen-address=0.0.0.0:8444")
+	assert.Contains(t, serveSidecar.Args, "--upstream=http://127.0.0.1:9000/")
+	assert.Contains(t, serveSidecar.Args, "--config-file=/etc/kube-rbac-proxy/config.yaml")
+
+	// The client listener forwards gRPC in cleartext to the default client port.
+	clientSidecar := GetClientProxySidecar(cluster, nil)
+	assert.Equal(t, clientProxyContainerName, clientSidecar.Name)
+	assert.Equal(t, int32(utils.DefaultClientAuthProxyPort), clientSidecar.Ports[0].ContainerPort)
+	assert.Contains(t, clientSidecar.Args, "--secure-listen-address=0.0.0.0:10443")
+	assert.Contains(t, clientSidecar.Args, "--upstream=http://127.0.0.1:10001/")
+	assert.Contains(t, clientSidecar.Args, "--upstream-force-h2c=true")
+}
+
+func TestAuthProxyConfig(t *testing.T) {
+	cluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cluster",
+			Namespace: "default",
+		},
+	}
+	resources := corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+	}
+	config := &configapi.AuthProxyConfig{
+		OAuthProxyImage:    "mirror.example.com/oauth-proxy:v4.17",
+		KubeRBACProxyImage: "mirror.example.com/kube-rbac-proxy:v0.18",
+		ImagePullPolicy:    corev1.PullAlways,
+		Resources:          &resources,
+		ExtraArgs:          []string{"--v=2"},
+	}
+
+	oidcSidecar := GetOIDCProxySidecar(cluster, config)
+	assert.Equal(t, "mirror.example.com/kube-rbac-proxy:v0.18", oidcSidecar.Image)
+	assert.Equal(t, corev1.PullAlways, oidcSidecar.ImagePullPolicy)
+	assert.Equal(t, resources, oidcSidecar.Resources)
+	assert.Equal(t, "--v=2", oidcSidecar.Args[len(oidcSidecar.Args)-1])
+
+	oauthSidecar := GetOAuthProxySidecar(cluster, config)
+	assert.Equal(t, "mirror.example.com/oauth-proxy:v4.17", oauthSidecar.Image)
+	assert.Equal(t, corev1.PullAlways, oauthSidecar.ImagePullPolicy)
+	assert.Equal(t, resources, oauthSidecar.Resources)
+	assert.Equal(t, "--v=2", oauthSidecar.Args[len(oauthSidecar.Args)-1])
+
+	// Fields that are not set keep their defaults.
+	oidcSidecar = GetOIDCProxySidecar(cluster, &configapi.AuthProxyConfig{OAuthProxyImage: "mirror.example.com/oauth-proxy:v4.17"})
+	assert.Equal(t, GetOIDCProxySidecar(cluster, nil), oidcSidecar)
+}
+
 func TestGetOIDCProxyVolumes(t *testing.T) {
 	cluster := &rayv1.RayCluster{
 		ObjectMeta: metav1.ObjectMeta{
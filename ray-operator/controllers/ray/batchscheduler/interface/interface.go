@@ -9,9 +9,20 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/common"
 )
 
+// SubmitterGroupName is the group name passed to AddMetadataToPod for the submitter pod of a RayJob.
+const SubmitterGroupName = "submitter"
+
 // BatchScheduler manages submitting RayCluster pods to a third-party scheduler.
+//
+// The rayJob passed to the methods is the RayJob that owns the RayCluster, or nil if the RayCluster
+// isn't created by a RayJob. The submitter pod of the RayJob is part of the gang of the RayCluster,
+// but it is only created once the RayCluster is ready, so a gang that waits for it is never admitted.
+// Schedulers without placeholders, such as Volcano and scheduler-plugins, therefore reserve the
+// resources of the submitter pod with the ones of the RayCluster but leave it out of minMember.
+// YuniKorn reserves the submitter pod with the placeholder of its own task group.
 type BatchScheduler interface {
 	// Name corresponds to the schedulerName in Kubernetes:
 	// https://kubernetes.io/docs/tasks/extend-kubernetes/configure-multiple-schedulers/
@@ -19,11 +30,12 @@ type BatchScheduler interface {
 
 	// DoBatchSchedulingOnSubmission handles submitting the RayCluster to the batch scheduler on creation / update
 	// For most batch schedulers, this results in the creation of a PodGroup.
-	DoBatchSchedulingOnSubmission(ctx context.Context, app *rayv1.RayCluster) error
+	DoBatchSchedulingOnSubmission(ctx context.Context, app *rayv1.RayCluster, rayJob *rayv1.RayJob) error
 
 	// AddMetadataToPod enriches Pod specs with metadata necessary to tie them to the scheduler.
 	// For example, setting labels for queues / priority, and setting schedulerName.
-	AddMetadataToPod(ctx context.Context, app *rayv1.RayCluster, groupName string, pod *corev1.Pod)
+	// The submitter pod of the RayJob is passed with the SubmitterGroupName group name.
+	AddMetadataToPod(ctx context.Context, app *rayv1.RayCluster, rayJob *rayv1.RayJob, groupName string, pod *corev1.Pod)
 }
 
 // BatchSchedulerFactory handles initial setup of the scheduler plugin by registering the
@@ -52,11 +64,11 @@ func (d *DefaultBatchScheduler) Name() string {
 	return GetDefaultPluginName()
 }
 
-func (d *DefaultBatchScheduler) DoBatchSchedulingOnSubmission(_ context.Context, _ *rayv1.RayCluster) error {
+func (d *DefaultBatchScheduler) DoBatchSchedulingOnSubmission(_ context.Context, _ *rayv1.RayCluster, _ *rayv1.RayJob) error {
 	return nil
 }
 
-func (d *DefaultBatchScheduler) AddMetadataToPod(_ context.Context, _ *rayv1.RayCluster, _ string, _ *corev1.Pod) {
+func (d *DefaultBatchScheduler) AddMetadataToPod(_ context.Context, _ *rayv1.RayCluster, _ *rayv1.RayJob, _ string, _ *corev1.Pod) {
 }
 
 func (df *DefaultBatchSchedulerFactory) New(_ context.Context, _ *rest.Config) (BatchScheduler, error) {
@@ -69,3 +81,16 @@ func (df *DefaultBatchSchedulerFactory) AddToScheme(_ *runtime.Scheme) {
 func (df *DefaultBatchSchedulerFactory) ConfigureReconciler(b *builder.Builder) *builder.Builder {
 	return b
 }
+
+// GetSubmitterPodSpec returns the spec of the submitter pod that the RayJob creates for the RayCluster,
+// or nil if the RayJob doesn't create a submitter pod.
+func GetSubmitterPodSpec(app *rayv1.RayCluster, rayJob *rayv1.RayJob) *corev1.PodSpec {
+	if rayJob == nil || rayJob.Spec.SubmissionMode != rayv1.K8sJobMode {
+		return nil
+	}
+	if rayJob.Spec.SubmitterPodTemplate != nil {
+		return &rayJob.Spec.SubmitterPodTemplate.Spec
+	}
+	submitterTemplate := common.GetDefaultSubmitterTemplate(app)
+	return &submitterTemplate.Spec
+}
@@ -10,6 +10,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	ktypes "k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	quotav1 "k8s.io/apiserver/pkg/quota/v1"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -40,7 +41,7 @@ func (k *KubeScheduler) Name() string {
 	return GetPluginName()
 }
 
-func createPodGroup(_ context.Context, app *rayv1.RayCluster) *v1alpha1.PodGroup {
+func createPodGroup(_ context.Context, app *rayv1.RayCluster, rayJob *rayv1.RayJob) *v1alpha1.PodGroup {
 	// we set replica as 1 for the head pod
 	replica := int32(1)
 	for _, workerGroup := range app.Spec.WorkerGroupSpecs {
@@ -51,6 +52,13 @@ func createPodGroup(_ context.Context, app *rayv1.RayCluster) *v1alpha1.PodGroup
 		replica += *workerGroup.Replicas
 	}
 
+	// The submitter pod of the RayJob is only created once the RayCluster is ready, so it isn't
+	// counted in MinMember, but its resources are reserved with the ones of the RayCluster.
+	minResources := utils.CalculateDesiredResources(app)
+	if submitterPodSpec := schedulerinterface.GetSubmitterPodSpec(app, rayJob); submitterPodSpec != nil {
+		minResources = quotav1.Add(minResources, utils.CalculatePodResource(*submitterPodSpec))
+	}
+
 	podGroup := &v1alpha1.PodGroup{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: app.Namespace,
@@ -66,13 +74,13 @@ func createPodGroup(_ context.Context, app *rayv1.RayCluster) *v1alpha1.PodGroup
 		},
 		Spec: v1alpha1.PodGroupSpec{
 			MinMember:    replica,
-			MinResources: utils.CalculateDesiredResources(app),
+			MinResources: minResources,
 		},
 	}
 	return podGroup
 }
 
-func (k *KubeScheduler) DoBatchSchedulingOnSubmission(ctx context.Context, app *rayv1.RayCluster) error {
+func (k *KubeScheduler) DoBatchSchedulingOnSubmission(ctx context.Context, app *rayv1.RayCluster, rayJob *rayv1.RayJob) error {
 	if !k.isGangSchedulingEnabled(app) {
 		return nil
 	}
@@ -81,7 +89,7 @@ func (k *KubeScheduler) DoBatchSchedulingOnSubmission(ctx context.Context, app *
 		if !errors.IsNotFound(err) {
 			return err
 		}
-		podGroup = createPodGroup(ctx, app)
+		podGroup = createPodGroup(ctx, app, rayJob)
 		if err := k.cli.Create(ctx, podGroup); err != nil {
 			if errors.IsAlreadyExists(err) {
 				return nil
@@ -94,7 +102,7 @@ func (k *KubeScheduler) DoBatchSchedulingOnSubmission(ctx context.Context, app *
 
 // AddMetadataToPod adds essential labels and annotations to the Ray pods
 // the scheduler needs these labels and annotations in order to do the scheduling properly
-func (k *KubeScheduler) AddMetadataToPod(_ context.Context, app *rayv1.RayCluster, _ string, pod *corev1.Pod) {
+func (k *KubeScheduler) AddMetadataToPod(_ context.Context, app *rayv1.RayCluster, _ *rayv1.RayJob, _ string, pod *corev1.Pod) {
 	// when gang scheduling is enabled, extra labels need to be added to all pods
 	if k.isGangSchedulingEnabled(app) {
 		pod.Labels[kubeSchedulerPodGroupLabelKey] = app.Name
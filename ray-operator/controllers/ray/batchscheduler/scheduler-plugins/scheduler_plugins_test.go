@@ -11,6 +11,8 @@ import (
 	"k8s.io/utils/ptr"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	schedulerinterface "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/interface"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
 )
 
 func createTestRayCluster(numOfHosts int32) rayv1.RayCluster {
@@ -82,7 +84,7 @@ func TestCreatePodGroup(t *testing.T) {
 
 	cluster := createTestRayCluster(1)
 
-	podGroup := createPodGroup(context.TODO(), &cluster)
+	podGroup := createPodGroup(context.TODO(), &cluster, nil)
 
 	// 256m * 3 (requests, not limits)
 	a.Equal("768m", podGroup.Spec.MinResources.Cpu().String())
@@ -96,3 +98,56 @@ func TestCreatePodGroup(t *testing.T) {
 	// 1 head and 2 workers
 	a.Equal(int32(3), podGroup.Spec.MinMember)
 }
+
+func TestCreatePodGroup_RayJobSubmitter(t *testing.T) {
+	a := assert.New(t)
+
+	cluster := createTestRayCluster(1)
+	rayJob := &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "rayjob-sample", Namespace: cluster.Namespace},
+		Spec:       rayv1.RayJobSpec{SubmissionMode: rayv1.K8sJobMode},
+	}
+
+	podGroup := createPodGroup(context.TODO(), &cluster, rayJob)
+
+	// 256m * 3 + 500m for the default submitter pod
+	a.Equal("1268m", podGroup.Spec.MinResources.Cpu().String())
+
+	// 256Mi * 3 + 200Mi for the default submitter pod
+	a.Equal("968Mi", podGroup.Spec.MinResources.Memory().String())
+
+	// The submitter pod is created once the RayCluster is ready, so it isn't counted in MinMember.
+	a.Equal(int32(3), podGroup.Spec.MinMember)
+
+	// No submitter pod is created in InteractiveMode.
+	rayJob.Spec.SubmissionMode = rayv1.InteractiveMode
+	podGroup = createPodGroup(context.TODO(), &cluster, rayJob)
+	a.Equal("768m", podGroup.Spec.MinResources.Cpu().String())
+
+	// The submitter pod is labeled with the PodGroup of the RayCluster.
+	cluster.Labels = map[string]string{utils.RayClusterGangSchedulingEnabled: "true"}
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{}, Annotations: map[string]string{}}}
+	scheduler := &KubeScheduler{}
+	scheduler.AddMetadataToPod(context.TODO(), &cluster, rayJob, schedulerinterface.SubmitterGroupName, pod)
+	a.Equal(cluster.Name, pod.Labels[kubeSchedulerPodGroupLabelKey])
+}
+
+func TestCreatePodGroup_AdmitsRayClusterBeforeSubmitter(t *testing.T) {
+	a := assert.New(t)
+
+	cluster := createTestRayCluster(1)
+	rayJob := &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "rayjob-sample", Namespace: cluster.Namespace},
+		Spec:       rayv1.RayJobSpec{SubmissionMode: rayv1.K8sJobMode},
+	}
+
+	podGroup := createPodGroup(context.TODO(), &cluster, rayJob)
+
+	// The submitter pod is only created once the RayCluster is ready, so the PodGroup is admitted
+	// with the pods of the RayCluster alone: 1 head + 2 workers = 3
+	a.Equal(int32(3), podGroup.Spec.MinMember)
+
+	// The resources of the submitter pod are reserved with the ones of the RayCluster
+	// 256m * 3 + 500m for the default submitter pod = 1268m
+	a.Equal("1268m", podGroup.Spec.MinResources.Cpu().String())
+}
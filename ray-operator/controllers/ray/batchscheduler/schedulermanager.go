@@ -10,21 +10,27 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 
 	configapi "github.com/ray-project/kuberay/ray-operator/apis/config/v1alpha1"
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
 	schedulerinterface "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/interface"
 	schedulerplugins "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/scheduler-plugins"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/volcano"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/yunikorn"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
 )
 
 type SchedulerManager struct {
-	config     *rest.Config
-	factory    schedulerinterface.BatchSchedulerFactory
-	scheduler  schedulerinterface.BatchScheduler
-	rayConfigs configapi.Configuration
+	config *rest.Config
+	// factories and schedulers are keyed by the scheduler name.
+	factories        map[string]schedulerinterface.BatchSchedulerFactory
+	schedulers       map[string]schedulerinterface.BatchScheduler
+	defaultScheduler schedulerinterface.BatchScheduler
+	rayConfigs       configapi.Configuration
 	sync.Mutex
 }
 
-// NewSchedulerManager maintains a specific scheduler plugin based on config
+// NewSchedulerManager maintains the scheduler plugins based on config. The scheduler from BatchScheduler
+// is the default one, and the additional schedulers from BatchSchedulers are selected by RayClusters with
+// the ray.io/scheduler-name label.
 func NewSchedulerManager(ctx context.Context, rayConfigs configapi.Configuration, config *rest.Config) (*SchedulerManager, error) {
 	// init the scheduler factory from config
 	factory, err := getSchedulerFactory(rayConfigs)
@@ -38,10 +44,33 @@ func NewSchedulerManager(ctx context.Context, rayConfigs configapi.Configuration
 	}
 
 	manager := SchedulerManager{
-		rayConfigs: rayConfigs,
-		config:     config,
-		factory:    factory,
-		scheduler:  scheduler,
+		rayConfigs:       rayConfigs,
+		config:           config,
+		factories:        map[string]schedulerinterface.BatchSchedulerFactory{scheduler.Name(): factory},
+		schedulers:       map[string]schedulerinterface.BatchScheduler{scheduler.Name(): scheduler},
+		defaultScheduler: scheduler,
+	}
+
+	// RayClusters can opt out of the default scheduler with the default (no-op) scheduler.
+	if _, exist := manager.schedulers[schedulerinterface.GetDefaultPluginName()]; !exist {
+		manager.factories[schedulerinterface.GetDefaultPluginName()] = &schedulerinterface.DefaultBatchSchedulerFactory{}
+		manager.schedulers[schedulerinterface.GetDefaultPluginName()] = &schedulerinterface.DefaultBatchScheduler{}
+	}
+
+	for _, name := range rayConfigs.BatchSchedulers {
+		if _, exist := manager.schedulers[name]; exist {
+			continue
+		}
+		factory, err := newSchedulerFactory(name)
+		if err != nil {
+			return nil, err
+		}
+		scheduler, err := factory.New(ctx, config)
+		if err != nil {
+			return nil, err
+		}
+		manager.factories[name] = factory
+		manager.schedulers[name] = scheduler
 	}
 
 	return &manager, nil
@@ -54,15 +83,9 @@ func getSchedulerFactory(rayConfigs configapi.Configuration) (schedulerinterface
 	// only support a white list of names, empty value is the default value
 	// it throws error if an unknown name is provided
 	if len(rayConfigs.BatchScheduler) > 0 {
-		switch rayConfigs.BatchScheduler {
-		case volcano.GetPluginName():
-			factory = &volcano.VolcanoBatchSchedulerFactory{}
-		case yunikorn.GetPluginName():
-			factory = &yunikorn.YuniKornSchedulerFactory{}
-		case schedulerplugins.GetPluginName():
-			factory = &schedulerplugins.KubeSchedulerFactory{}
-		default:
-			return nil, fmt.Errorf("the scheduler is not supported, name=%s", rayConfigs.BatchScheduler)
+		var err error
+		if factory, err = newSchedulerFactory(rayConfigs.BatchScheduler); err != nil {
+			return nil, err
 		}
 	} else {
 		// empty is the default value, when not set
@@ -79,15 +102,44 @@ func getSchedulerFactory(rayConfigs configapi.Configuration) (schedulerinterface
 	return factory, nil
 }
 
-func (batch *SchedulerManager) GetSchedulerForCluster() (schedulerinterface.BatchScheduler, error) {
-	return batch.scheduler, nil
+// newSchedulerFactory returns the factory of the scheduler plugin with the given name.
+func newSchedulerFactory(name string) (schedulerinterface.BatchSchedulerFactory, error) {
+	switch name {
+	case volcano.GetPluginName():
+		return &volcano.VolcanoBatchSchedulerFactory{}, nil
+	case yunikorn.GetPluginName():
+		return &yunikorn.YuniKornSchedulerFactory{}, nil
+	case schedulerplugins.GetPluginName():
+		return &schedulerplugins.KubeSchedulerFactory{}, nil
+	default:
+		return nil, fmt.Errorf("the scheduler is not supported, name=%s", name)
+	}
+}
+
+// GetSchedulerForCluster returns the scheduler selected by the ray.io/scheduler-name label of the RayCluster,
+// or the default scheduler if the label isn't set. If the label selects a scheduler that isn't enabled in the
+// operator, it falls back to the default scheduler and returns false, so that the RayCluster is still created.
+func (batch *SchedulerManager) GetSchedulerForCluster(app *rayv1.RayCluster) (schedulerinterface.BatchScheduler, bool) {
+	name, exist := app.Labels[utils.RaySchedulerName]
+	if !exist || name == "" {
+		return batch.defaultScheduler, true
+	}
+	scheduler, exist := batch.schedulers[name]
+	if !exist {
+		return batch.defaultScheduler, false
+	}
+	return scheduler, true
 }
 
 func (batch *SchedulerManager) ConfigureReconciler(b *builder.Builder) *builder.Builder {
-	batch.factory.ConfigureReconciler(b)
+	for _, factory := range batch.factories {
+		b = factory.ConfigureReconciler(b)
+	}
 	return b
 }
 
 func (batch *SchedulerManager) AddToScheme(scheme *runtime.Scheme) {
-	batch.factory.AddToScheme(scheme)
+	for _, factory := range batch.factories {
+		factory.AddToScheme(scheme)
+	}
 }
@@ -1,15 +1,20 @@
 package batchscheduler
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/ray-project/kuberay/ray-operator/apis/config/v1alpha1"
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
 	schedulerinterface "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/interface"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/volcano"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/yunikorn"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
 )
 
 func TestGetSchedulerFactory(t *testing.T) {
@@ -141,3 +146,72 @@ func TestGetSchedulerFactory(t *testing.T) {
 		})
 	}
 }
+
+func TestGetSchedulerForCluster(t *testing.T) {
+	// The default and the yunikorn schedulers don't need a connection to the cluster.
+	manager, err := NewSchedulerManager(context.TODO(), v1alpha1.Configuration{
+		BatchSchedulers: []string{yunikorn.GetPluginName()},
+	}, nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		labels      map[string]string
+		name        string
+		want        string
+		wantEnabled bool
+	}{
+		{
+			name:        "RayCluster without the scheduler name label uses the default scheduler",
+			want:        schedulerinterface.GetDefaultPluginName(),
+			wantEnabled: true,
+		},
+		{
+			name:        "RayCluster with an empty scheduler name label uses the default scheduler",
+			labels:      map[string]string{utils.RaySchedulerName: ""},
+			want:        schedulerinterface.GetDefaultPluginName(),
+			wantEnabled: true,
+		},
+		{
+			name:        "RayCluster selects an additional scheduler",
+			labels:      map[string]string{utils.RaySchedulerName: yunikorn.GetPluginName()},
+			want:        yunikorn.GetPluginName(),
+			wantEnabled: true,
+		},
+		{
+			name:        "RayCluster selects a scheduler that isn't enabled and falls back to the default scheduler",
+			labels:      map[string]string{utils.RaySchedulerName: volcano.GetPluginName()},
+			want:        schedulerinterface.GetDefaultPluginName(),
+			wantEnabled: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &rayv1.RayCluster{ObjectMeta: metav1.ObjectMeta{Labels: tt.labels}}
+			scheduler, enabled := manager.GetSchedulerForCluster(cluster)
+			assert.Equal(t, tt.wantEnabled, enabled)
+			assert.Equal(t, tt.want, scheduler.Name())
+		})
+	}
+
+	// RayClusters can opt out of the default scheduler with the default (no-op) scheduler.
+	manager, err = NewSchedulerManager(context.TODO(), v1alpha1.Configuration{BatchScheduler: yunikorn.GetPluginName()}, nil)
+	require.NoError(t, err)
+	scheduler, enabled := manager.GetSchedulerForCluster(&rayv1.RayCluster{})
+	assert.True(t, enabled)
+	assert.Equal(t, yunikorn.GetPluginName(), scheduler.Name())
+	scheduler, enabled = manager.GetSchedulerForCluster(&rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{utils.RaySchedulerName: schedulerinterface.GetDefaultPluginName()}},
+	})
+	assert.True(t, enabled)
+	assert.Equal(t, schedulerinterface.GetDefaultPluginName(), scheduler.Name())
+
+	// A RayCluster selecting a scheduler on an operator without batch schedulers uses the default (no-op) scheduler.
+	manager, err = NewSchedulerManager(context.TODO(), v1alpha1.Configuration{}, nil)
+	require.NoError(t, err)
+	scheduler, enabled = manager.GetSchedulerForCluster(&rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{utils.RaySchedulerName: volcano.GetPluginName()}},
+	})
+	assert.False(t, enabled)
+	assert.Equal(t, schedulerinterface.GetDefaultPluginName(), scheduler.Name())
+}
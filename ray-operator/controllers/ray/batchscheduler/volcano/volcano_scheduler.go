@@ -45,7 +45,13 @@ func (v *VolcanoBatchScheduler) Name() string {
 	return GetPluginName()
 }
 
-func (v *VolcanoBatchScheduler) DoBatchSchedulingOnSubmission(ctx context.Context, app *rayv1.RayCluster) error {
+func (v *VolcanoBatchScheduler) DoBatchSchedulingOnSubmission(ctx context.Context, app *rayv1.RayCluster, rayJob *rayv1.RayJob) error {
+	minMember, totalResource := calculatePodGroupParams(ctx, app, rayJob)
+	return v.syncPodGroup(ctx, app, minMember, totalResource)
+}
+
+// calculatePodGroupParams returns the minMember and the minResources of the PodGroup of the RayCluster.
+func calculatePodGroupParams(ctx context.Context, app *rayv1.RayCluster, rayJob *rayv1.RayJob) (int32, corev1.ResourceList) {
 	var minMember int32
 	var totalResource corev1.ResourceList
 	if !utils.IsAutoscalingEnabled(&app.Spec) {
@@ -55,8 +61,13 @@ func (v *VolcanoBatchScheduler) DoBatchSchedulingOnSubmission(ctx context.Contex
 		minMember = utils.CalculateMinReplicas(app) + 1
 		totalResource = utils.CalculateMinResources(app)
 	}
-
-	return v.syncPodGroup(ctx, app, minMember, totalResource)
+	// The submitter pod of the RayJob is only created once the RayCluster is ready, so it isn't
+	// counted in minMember, which would never be reached otherwise. Its resources are reserved
+	// with the ones of the RayCluster so that it can be scheduled once the RayCluster is ready.
+	if submitterPodSpec := schedulerinterface.GetSubmitterPodSpec(app, rayJob); submitterPodSpec != nil {
+		totalResource = quotav1.Add(totalResource, utils.CalculatePodResource(*submitterPodSpec))
+	}
+	return minMember, totalResource
 }
 
 func getAppPodGroupName(app *rayv1.RayCluster) string {
@@ -131,7 +142,7 @@ func createPodGroup(
 	return podGroup
 }
 
-func (v *VolcanoBatchScheduler) AddMetadataToPod(_ context.Context, app *rayv1.RayCluster, groupName string, pod *corev1.Pod) {
+func (v *VolcanoBatchScheduler) AddMetadataToPod(_ context.Context, app *rayv1.RayCluster, _ *rayv1.RayJob, groupName string, pod *corev1.Pod) {
 	pod.Annotations[v1beta1.KubeGroupNameAnnotationKey] = getAppPodGroupName(app)
 	pod.Annotations[volcanov1alpha1.TaskSpecKey] = groupName
 	if queue, ok := app.ObjectMeta.Labels[QueueNameLabelKey]; ok {
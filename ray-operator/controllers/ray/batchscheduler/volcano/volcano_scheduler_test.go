@@ -9,8 +9,11 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
+	volcanov1alpha1 "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	schedulerinterface "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/interface"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
 )
 
@@ -129,3 +132,80 @@ func TestCreatePodGroup_NumOfHosts2(t *testing.T) {
 	// 2 GPUs * 2 = 4 GPUs
 	a.Equal("4", pg.Spec.MinResources.Name("nvidia.com/gpu", resource.BinarySI).String())
 }
+
+func TestCalculatePodGroupParams_RayJobSubmitter(t *testing.T) {
+	a := assert.New(t)
+	ctx := context.Background()
+
+	cluster := createTestRayCluster(1)
+	rayJob := &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "rayjob-sample", Namespace: cluster.Namespace},
+		Spec:       rayv1.RayJobSpec{SubmissionMode: rayv1.K8sJobMode},
+	}
+
+	// The submitter pod is created once the RayCluster is ready, so it isn't counted in minMember.
+	minMember, totalResource := calculatePodGroupParams(ctx, &cluster, rayJob)
+	a.Equal(int32(3), minMember)
+
+	// 256m * 3 + 500m for the default submitter pod
+	a.Equal("1268m", totalResource.Cpu().String())
+
+	// 256Mi * 3 + 200Mi for the default submitter pod
+	a.Equal("968Mi", totalResource.Memory().String())
+
+	// The resources of the submitter pod template of the RayJob are used if it's set.
+	rayJob.Spec.SubmitterPodTemplate = &corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: "ray-job-submitter",
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("1"),
+							corev1.ResourceMemory: resource.MustParse("1Gi"),
+						},
+					},
+				},
+			},
+		},
+	}
+	_, totalResource = calculatePodGroupParams(ctx, &cluster, rayJob)
+	a.Equal("1768m", totalResource.Cpu().String())
+	a.Equal("1792Mi", totalResource.Memory().String())
+
+	// No submitter pod is created in HTTPMode.
+	rayJob.Spec.SubmissionMode = rayv1.HTTPMode
+	_, totalResource = calculatePodGroupParams(ctx, &cluster, rayJob)
+	a.Equal("768m", totalResource.Cpu().String())
+	a.Equal("768Mi", totalResource.Memory().String())
+
+	// The submitter pod belongs to the PodGroup of the RayCluster.
+	scheduler := &VolcanoBatchScheduler{}
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{}, Annotations: map[string]string{}}}
+	scheduler.AddMetadataToPod(ctx, &cluster, rayJob, schedulerinterface.SubmitterGroupName, pod)
+	a.Equal(getAppPodGroupName(&cluster), pod.Annotations[v1beta1.KubeGroupNameAnnotationKey])
+	a.Equal(schedulerinterface.SubmitterGroupName, pod.Annotations[volcanov1alpha1.TaskSpecKey])
+	a.Equal(GetPluginName(), pod.Spec.SchedulerName)
+}
+
+func TestCreatePodGroup_AdmitsRayClusterBeforeSubmitter(t *testing.T) {
+	a := assert.New(t)
+	ctx := context.Background()
+
+	cluster := createTestRayCluster(2)
+	rayJob := &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "rayjob-sample", Namespace: cluster.Namespace},
+		Spec:       rayv1.RayJobSpec{SubmissionMode: rayv1.K8sJobMode},
+	}
+
+	minMember, totalResource := calculatePodGroupParams(ctx, &cluster, rayJob)
+	pg := createPodGroup(&cluster, getAppPodGroupName(&cluster), minMember, totalResource)
+
+	// The submitter pod is only created once the RayCluster is ready, so the PodGroup is admitted
+	// with the pods of the RayCluster alone: 1 head + 2 workers * 2 (num of hosts) = 5
+	a.Equal(int32(5), pg.Spec.MinMember)
+
+	// The resources of the submitter pod are reserved with the ones of the RayCluster
+	// 256m * 5 + 500m for the default submitter pod = 1780m
+	a.Equal("1780m", pg.Spec.MinResources.Cpu().String())
+}
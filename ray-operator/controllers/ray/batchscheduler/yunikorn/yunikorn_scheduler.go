@@ -36,7 +36,7 @@ func (y *YuniKornScheduler) Name() string {
 	return GetPluginName()
 }
 
-func (y *YuniKornScheduler) DoBatchSchedulingOnSubmission(_ context.Context, _ *rayv1.RayCluster) error {
+func (y *YuniKornScheduler) DoBatchSchedulingOnSubmission(_ context.Context, _ *rayv1.RayCluster, _ *rayv1.RayJob) error {
 	// yunikorn doesn't require any resources to be created upfront
 	// this is a no-opt for this implementation
 	return nil
@@ -60,7 +60,7 @@ func (y *YuniKornScheduler) populatePodLabels(ctx context.Context, app *rayv1.Ra
 
 // AddMetadataToPod adds essential labels and annotations to the Ray pods
 // the yunikorn scheduler needs these labels and annotations in order to do the scheduling properly
-func (y *YuniKornScheduler) AddMetadataToPod(ctx context.Context, app *rayv1.RayCluster, groupName string, pod *corev1.Pod) {
+func (y *YuniKornScheduler) AddMetadataToPod(ctx context.Context, app *rayv1.RayCluster, rayJob *rayv1.RayJob, groupName string, pod *corev1.Pod) {
 	// the applicationID and queue name must be provided in the labels
 	y.populatePodLabels(ctx, app, pod, RayClusterApplicationIDLabelName, YuniKornPodApplicationIDLabelName)
 	y.populatePodLabels(ctx, app, pod, RayClusterQueueLabelName, YuniKornPodQueueLabelName)
@@ -69,9 +69,9 @@ func (y *YuniKornScheduler) AddMetadataToPod(ctx context.Context, app *rayv1.Ray
 	// when gang scheduling is enabled, extra annotations need to be added to all pods
 	if y.isGangSchedulingEnabled(app) {
 		// populate the taskGroups info to each pod
-		y.populateTaskGroupsAnnotationToPod(ctx, app, pod)
+		y.populateTaskGroupsAnnotationToPod(ctx, app, rayJob, pod)
 
-		// set the task group name based on the head or worker group name
+		// set the task group name based on the head, worker or submitter group name
 		// the group name for the head and each of the worker group should be different
 		pod.Annotations[YuniKornTaskGroupNameAnnotationName] = groupName
 	}
@@ -82,9 +82,9 @@ func (y *YuniKornScheduler) isGangSchedulingEnabled(app *rayv1.RayCluster) bool
 	return exist
 }
 
-func (y *YuniKornScheduler) populateTaskGroupsAnnotationToPod(ctx context.Context, app *rayv1.RayCluster, pod *corev1.Pod) {
+func (y *YuniKornScheduler) populateTaskGroupsAnnotationToPod(ctx context.Context, app *rayv1.RayCluster, rayJob *rayv1.RayJob, pod *corev1.Pod) {
 	logger := ctrl.LoggerFrom(ctx).WithName(SchedulerName)
-	taskGroups := newTaskGroupsFromApp(app)
+	taskGroups := newTaskGroupsFromApp(app, rayJob)
 	taskGroupsAnnotationValue, err := taskGroups.marshal()
 	if err != nil {
 		logger.Error(err, "failed to add gang scheduling related annotations to pod, "+
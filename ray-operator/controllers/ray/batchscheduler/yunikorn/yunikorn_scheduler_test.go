@@ -13,6 +13,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	schedulerinterface "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/interface"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
 )
 
@@ -150,7 +151,7 @@ func TestPopulateGangSchedulingAnnotations(t *testing.T) {
 
 	// gang-scheduling enabled case, the plugin should populate the taskGroup annotation to the app
 	rayPod := createPod("ray-pod", "default")
-	yk.populateTaskGroupsAnnotationToPod(ctx, rayClusterWithGangScheduling, rayPod)
+	yk.populateTaskGroupsAnnotationToPod(ctx, rayClusterWithGangScheduling, nil, rayPod)
 
 	kk, err := getTaskGroupsFromAnnotation(rayPod)
 	require.NoError(t, err)
@@ -179,6 +180,128 @@ func TestPopulateGangSchedulingAnnotations(t *testing.T) {
 	assert.Equal(t, resource.MustParse("1"), workerGroup.MinResource["nvidia.com/gpu"])
 }
 
+func TestAddMetadataToPod_RayJobSubmitter(t *testing.T) {
+	yk := &YuniKornScheduler{}
+	ctx := context.Background()
+
+	rayCluster := createRayClusterWithLabels(
+		"rayjob-sample-raycluster",
+		"test",
+		map[string]string{
+			RayClusterApplicationIDLabelName:      "job-1-01234",
+			RayClusterQueueLabelName:              "root.default",
+			utils.RayClusterGangSchedulingEnabled: "true",
+		},
+	)
+	addHeadPodSpec(rayCluster, v1.ResourceList{
+		v1.ResourceCPU:    resource.MustParse("5"),
+		v1.ResourceMemory: resource.MustParse("5Gi"),
+	})
+	addWorkerPodSpec(rayCluster,
+		"worker-group-1", 1, 1, 2, v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse("2"),
+			v1.ResourceMemory: resource.MustParse("10Gi"),
+		})
+
+	rayJob := &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "rayjob-sample", Namespace: "test"},
+		Spec: rayv1.RayJobSpec{
+			SubmissionMode: rayv1.K8sJobMode,
+			SubmitterPodTemplate: &v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name: "ray-job-submitter",
+							Resources: v1.ResourceRequirements{
+								Requests: v1.ResourceList{
+									v1.ResourceCPU:    resource.MustParse("1"),
+									v1.ResourceMemory: resource.MustParse("1Gi"),
+								},
+							},
+						},
+					},
+					NodeSelector: map[string]string{"pool": "cpu"},
+				},
+			},
+		},
+	}
+
+	// the submitter pod has its own task group, which is reserved with the ones of the RayCluster
+	submitterPod := createPod("rayjob-sample-submitter", "test")
+	yk.AddMetadataToPod(ctx, rayCluster, rayJob, schedulerinterface.SubmitterGroupName, submitterPod)
+	assert.Equal(t, SchedulerName, submitterPod.Spec.SchedulerName)
+	assert.True(t, podLabelsContains(submitterPod, YuniKornPodApplicationIDLabelName, "job-1-01234"))
+	assert.True(t, podLabelsContains(submitterPod, YuniKornPodQueueLabelName, "root.default"))
+	assert.Equal(t, schedulerinterface.SubmitterGroupName, submitterPod.Annotations[YuniKornTaskGroupNameAnnotationName])
+
+	taskGroups := newTaskGroups()
+	require.NoError(t, taskGroups.unmarshalFrom(submitterPod.Annotations[YuniKornTaskGroupsAnnotationName]))
+	assert.Len(t, taskGroups.Groups, 3)
+	submitterGroup := taskGroups.getTaskGroup(schedulerinterface.SubmitterGroupName)
+	assert.Equal(t, int32(1), submitterGroup.MinMember)
+	assert.Equal(t, resource.MustParse("1"), submitterGroup.MinResource[v1.ResourceCPU.String()])
+	assert.Equal(t, resource.MustParse("1Gi"), submitterGroup.MinResource[v1.ResourceMemory.String()])
+	assert.Equal(t, map[string]string{"pool": "cpu"}, submitterGroup.NodeSelector)
+
+	// the pods of the RayCluster carry the same task groups
+	headPod := createPod("rayjob-sample-head", "test")
+	yk.AddMetadataToPod(ctx, rayCluster, rayJob, utils.RayNodeHeadGroupLabelValue, headPod)
+	assert.Equal(t, submitterPod.Annotations[YuniKornTaskGroupsAnnotationName], headPod.Annotations[YuniKornTaskGroupsAnnotationName])
+
+	// no submitter task group is added if the RayJob doesn't create a submitter pod
+	rayJob.Spec.SubmissionMode = rayv1.HTTPMode
+	headPod = createPod("rayjob-sample-head", "test")
+	yk.AddMetadataToPod(ctx, rayCluster, rayJob, utils.RayNodeHeadGroupLabelValue, headPod)
+	taskGroups = newTaskGroups()
+	require.NoError(t, taskGroups.unmarshalFrom(headPod.Annotations[YuniKornTaskGroupsAnnotationName]))
+	assert.Len(t, taskGroups.Groups, 2)
+}
+
+func TestAddMetadataToPod_AdmitsRayClusterBeforeSubmitter(t *testing.T) {
+	yk := &YuniKornScheduler{}
+	ctx := context.Background()
+
+	rayCluster := createRayClusterWithLabels(
+		"rayjob-sample-raycluster",
+		"test",
+		map[string]string{
+			RayClusterApplicationIDLabelName:      "job-1-01234",
+			RayClusterQueueLabelName:              "root.default",
+			utils.RayClusterGangSchedulingEnabled: "true",
+		},
+	)
+	addHeadPodSpec(rayCluster, v1.ResourceList{
+		v1.ResourceCPU:    resource.MustParse("5"),
+		v1.ResourceMemory: resource.MustParse("5Gi"),
+	})
+	addWorkerPodSpec(rayCluster,
+		"worker-group-1", 2, 2, 2, v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse("2"),
+			v1.ResourceMemory: resource.MustParse("10Gi"),
+		})
+	rayJob := &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "rayjob-sample", Namespace: "test"},
+		Spec:       rayv1.RayJobSpec{SubmissionMode: rayv1.K8sJobMode},
+	}
+
+	// the pods of the RayCluster are created before the submitter pod, and each of them
+	// is scheduled in the task group of its own group, never in the submitter task group
+	headPod := createPod("rayjob-sample-head", "test")
+	yk.AddMetadataToPod(ctx, rayCluster, rayJob, utils.RayNodeHeadGroupLabelValue, headPod)
+	assert.Equal(t, utils.RayNodeHeadGroupLabelValue, headPod.Annotations[YuniKornTaskGroupNameAnnotationName])
+	workerPod := createPod("rayjob-sample-worker", "test")
+	yk.AddMetadataToPod(ctx, rayCluster, rayJob, "worker-group-1", workerPod)
+	assert.Equal(t, "worker-group-1", workerPod.Annotations[YuniKornTaskGroupNameAnnotationName])
+
+	// the submitter task group only needs one member, which YuniKorn fills with a placeholder
+	// until the submitter pod exists, so the gang is admitted with the pods of the RayCluster alone
+	taskGroups := newTaskGroups()
+	require.NoError(t, taskGroups.unmarshalFrom(headPod.Annotations[YuniKornTaskGroupsAnnotationName]))
+	assert.Equal(t, int32(1), taskGroups.getTaskGroup(utils.RayNodeHeadGroupLabelValue).MinMember)
+	assert.Equal(t, int32(2), taskGroups.getTaskGroup("worker-group-1").MinMember)
+	assert.Equal(t, int32(1), taskGroups.getTaskGroup(schedulerinterface.SubmitterGroupName).MinMember)
+}
+
 func createRayClusterWithLabels(name string, namespace string, labels map[string]string) *rayv1.RayCluster {
 	rayCluster := &rayv1.RayCluster{
 		ObjectMeta: metav1.ObjectMeta{
@@ -7,6 +7,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	v1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	schedulerinterface "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/interface"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
 )
 
@@ -33,7 +34,7 @@ func newTaskGroups() *TaskGroups {
 	}
 }
 
-func newTaskGroupsFromApp(app *v1.RayCluster) *TaskGroups {
+func newTaskGroupsFromApp(app *v1.RayCluster, rayJob *v1.RayJob) *TaskGroups {
 	taskGroups := newTaskGroups()
 
 	// head group
@@ -64,6 +65,21 @@ func newTaskGroupsFromApp(app *v1.RayCluster) *TaskGroups {
 			})
 	}
 
+	// submitter group, the placeholder of the submitter pod of the RayJob reserves its resources
+	// until the submitter pod is created once the RayCluster is ready
+	if submitterPodSpec := schedulerinterface.GetSubmitterPodSpec(app, rayJob); submitterPodSpec != nil {
+		submitterMinResource := utils.CalculatePodResource(*submitterPodSpec)
+		taskGroups.addTaskGroup(
+			TaskGroup{
+				Name:         schedulerinterface.SubmitterGroupName,
+				MinMember:    1,
+				MinResource:  utils.ConvertResourceListToMapString(submitterMinResource),
+				NodeSelector: submitterPodSpec.NodeSelector,
+				Tolerations:  submitterPodSpec.Tolerations,
+				Affinity:     submitterPodSpec.Affinity,
+			})
+	}
+
 	return taskGroups
 }
 
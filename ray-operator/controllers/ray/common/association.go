@@ -120,6 +120,26 @@ func RayClusterGroupPodsAssociationOptions(instance *rayv1.RayCluster, group str
 	}
 }
 
+func RayClusterPodDisruptionBudgetsAssociationOptions(instance *rayv1.RayCluster) AssociationOptions {
+	return AssociationOptions{
+		client.InNamespace(instance.Namespace),
+		client.MatchingLabels{
+			utils.RayClusterLabelKey:          instance.Name,
+			utils.KubernetesCreatedByLabelKey: utils.ComponentName,
+		},
+	}
+}
+
+func RayClusterPersistentVolumeClaimsAssociationOptions(instance *rayv1.RayCluster) AssociationOptions {
+	return AssociationOptions{
+		client.InNamespace(instance.Namespace),
+		client.MatchingLabels{
+			utils.RayClusterLabelKey:          instance.Name,
+			utils.KubernetesCreatedByLabelKey: utils.ComponentName,
+		},
+	}
+}
+
 func RayClusterAllPodsAssociationOptions(instance *rayv1.RayCluster) AssociationOptions {
 	return AssociationOptions{
 		client.InNamespace(instance.Namespace),
@@ -40,7 +40,7 @@ func BuildIngressForHeadService(ctx context.Context, cluster rayv1.RayCluster) (
 	}
 
 	pathType := networkingv1.PathTypeExact
-	servicePorts := getServicePorts(cluster)
+	servicePorts := GetServicePorts(cluster)
 	dashboardPort := int32(utils.DefaultDashboardPort)
 	if port, ok := servicePorts["dashboard"]; ok {
 		dashboardPort = port
@@ -0,0 +1,45 @@
+package common
+
+import (
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+// BuildPodDisruptionBudget builds the PodDisruptionBudget for a group of a RayCluster. The PodDisruptionBudget selects
+// the Pods of the group with the `ray.io/cluster` and `ray.io/group` labels, and its minAvailable is derived from
+// the desired replicas and NumOfHosts of the group.
+func BuildPodDisruptionBudget(cluster *rayv1.RayCluster, groupName string, budget rayv1.DisruptionBudget, replicas int32, numOfHosts int32) (*policyv1.PodDisruptionBudget, error) {
+	minAvailable, err := utils.CalculateDisruptionBudgetMinAvailablePods(budget, replicas, numOfHosts)
+	if err != nil {
+		return nil, err
+	}
+
+	pdb := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      utils.GenerateDisruptionBudgetName(cluster.Name, groupName),
+			Namespace: cluster.Namespace,
+			Labels: map[string]string{
+				utils.RayClusterLabelKey:                cluster.Name,
+				utils.RayNodeGroupLabelKey:              groupName,
+				utils.KubernetesApplicationNameLabelKey: utils.ApplicationName,
+				utils.KubernetesCreatedByLabelKey:       utils.ComponentName,
+			},
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MinAvailable: ptr.To(intstr.FromInt32(minAvailable)),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					utils.RayClusterLabelKey:   cluster.Name,
+					utils.RayNodeGroupLabelKey: groupName,
+				},
+			},
+		},
+	}
+
+	return pdb, nil
+}
@@ -0,0 +1,47 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+func TestBuildPodDisruptionBudget(t *testing.T) {
+	cluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "raycluster-sample",
+			Namespace: "default",
+		},
+	}
+
+	// A multi-host worker group with 3 replicas of 4 hosts may only lose 1 replica, i.e. 1 Pod.
+	budget := rayv1.DisruptionBudget{MaxUnavailable: ptr.To(intstr.FromInt32(1))}
+	pdb, err := BuildPodDisruptionBudget(cluster, "tpu-group", budget, 3, 4)
+	require.NoError(t, err)
+
+	assert.Equal(t, "raycluster-sample-tpu-group-pdb", pdb.Name)
+	assert.Equal(t, "default", pdb.Namespace)
+	assert.Equal(t, utils.ComponentName, pdb.Labels[utils.KubernetesCreatedByLabelKey])
+	assert.Equal(t, ptr.To(intstr.FromInt32(11)), pdb.Spec.MinAvailable)
+	assert.Nil(t, pdb.Spec.MaxUnavailable)
+	assert.Equal(t, map[string]string{
+		utils.RayClusterLabelKey:   "raycluster-sample",
+		utils.RayNodeGroupLabelKey: "tpu-group",
+	}, pdb.Spec.Selector.MatchLabels)
+
+	// The head group has a single replica.
+	pdb, err = BuildPodDisruptionBudget(cluster, utils.RayNodeHeadGroupLabelValue, rayv1.DisruptionBudget{MaxUnavailable: ptr.To(intstr.FromInt32(0))}, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "raycluster-sample-headgroup-pdb", pdb.Name)
+	assert.Equal(t, ptr.To(intstr.FromInt32(1)), pdb.Spec.MinAvailable)
+
+	_, err = BuildPodDisruptionBudget(cluster, "tpu-group", rayv1.DisruptionBudget{MinAvailable: ptr.To(intstr.FromString("half"))}, 3, 4)
+	require.Error(t, err)
+}
@@ -14,6 +14,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
@@ -1040,3 +1041,17 @@ func isGPUResourceKey(key string) bool {
 	match, _ := regexp.MatchString(`nvidia\.com/mig-\d+g\.\d+gb$`, key)
 	return match
 }
+
+// SetHostnameForSubdomain sets the hostname of a Pod with a subdomain to its name, so that the headless service
+// of the subdomain publishes a DNS record for the Pod. A Pod that would get its name from GenerateName is named
+// here in the same way as the API server would.
+func SetHostnameForSubdomain(pod *corev1.Pod) {
+	if pod.Spec.Subdomain == "" || pod.Spec.Hostname != "" {
+		return
+	}
+	if pod.Name == "" {
+		pod.Name = pod.GenerateName + utilrand.String(5)
+		pod.GenerateName = ""
+	}
+	pod.Spec.Hostname = pod.Name
+}
@@ -0,0 +1,84 @@
+package common
+
+import (
+	"maps"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+// AddVolumeClaimTemplateVolumes adds a volume for each volumeClaimTemplate to the Pod, replacing any volume with the
+// same name in the Pod template. The volumes refer to claims named after the group of the Pod and its volume claim
+// index rather than after the Pod, so that the Pod replacing a deleted Pod with the same index mounts its retained claims.
+func AddVolumeClaimTemplateVolumes(pod *corev1.Pod, clusterName string, templates []corev1.PersistentVolumeClaim, index int) {
+	if len(templates) == 0 {
+		return
+	}
+	if pod.Labels == nil {
+		pod.Labels = map[string]string{}
+	}
+	pod.Labels[utils.RayVolumeClaimIndexKey] = strconv.Itoa(index)
+	groupName := pod.Labels[utils.RayNodeGroupLabelKey]
+	for _, template := range templates {
+		volume := corev1.Volume{
+			Name: template.Name,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: utils.GenerateVolumeClaimName(template.Name, clusterName, groupName, index),
+				},
+			},
+		}
+		replaced := false
+		for i := range pod.Spec.Volumes {
+			if pod.Spec.Volumes[i].Name == volume.Name {
+				pod.Spec.Volumes[i] = volume
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			pod.Spec.Volumes = append(pod.Spec.Volumes, volume)
+		}
+	}
+}
+
+// GetVolumeClaimName returns the name of the claim that the Pod mounts for a volumeClaimTemplate, and whether the Pod
+// mounts one. Pods created before the template was added to their group don't.
+func GetVolumeClaimName(pod *corev1.Pod, templateName string) (string, bool) {
+	for _, volume := range pod.Spec.Volumes {
+		if volume.Name == templateName && volume.PersistentVolumeClaim != nil {
+			return volume.PersistentVolumeClaim.ClaimName, true
+		}
+	}
+	return "", false
+}
+
+// BuildVolumeClaim builds the PersistentVolumeClaim created from a volumeClaimTemplate for a Pod of a RayCluster.
+// The claim keeps the labels and annotations of the template, and is labeled with the cluster, group, node type and
+// volume claim index of the Pod.
+func BuildVolumeClaim(cluster *rayv1.RayCluster, template corev1.PersistentVolumeClaim, pod *corev1.Pod, claimName string) *corev1.PersistentVolumeClaim {
+	labels := make(map[string]string, len(template.Labels)+6)
+	maps.Copy(labels, template.Labels)
+	labels[utils.RayClusterLabelKey] = cluster.Name
+	labels[utils.RayNodeGroupLabelKey] = pod.Labels[utils.RayNodeGroupLabelKey]
+	labels[utils.RayNodeTypeLabelKey] = pod.Labels[utils.RayNodeTypeLabelKey]
+	labels[utils.KubernetesApplicationNameLabelKey] = utils.ApplicationName
+	labels[utils.KubernetesCreatedByLabelKey] = utils.ComponentName
+	if index, ok := pod.Labels[utils.RayVolumeClaimIndexKey]; ok {
+		labels[utils.RayVolumeClaimIndexKey] = index
+	}
+
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        claimName,
+			Namespace:   pod.Namespace,
+			Labels:      labels,
+			Annotations: maps.Clone(template.Annotations),
+		},
+		Spec: *template.Spec.DeepCopy(),
+	}
+}
@@ -0,0 +1,100 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+func TestAddVolumeClaimTemplateVolumes(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "raycluster-sample-worker-",
+			Labels:       map[string]string{utils.RayNodeGroupLabelKey: "worker-group"},
+		},
+		Spec: corev1.PodSpec{
+			Volumes: []corev1.Volume{
+				{Name: "data", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+				{Name: "logs", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+			},
+		},
+	}
+	templates := []corev1.PersistentVolumeClaim{
+		{ObjectMeta: metav1.ObjectMeta{Name: "data"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "cache"}},
+	}
+
+	AddVolumeClaimTemplateVolumes(pod, "raycluster-sample", templates, 2)
+
+	// The claims are named after the group and the volume claim index, not after the Pod.
+	assert.Equal(t, "raycluster-sample-worker-", pod.GenerateName)
+	assert.Equal(t, "2", pod.Labels[utils.RayVolumeClaimIndexKey])
+
+	// The volume with the same name as a template is replaced, and the other volumes are kept.
+	assert.Len(t, pod.Spec.Volumes, 3)
+	assert.Equal(t, "data", pod.Spec.Volumes[0].Name)
+	assert.Nil(t, pod.Spec.Volumes[0].EmptyDir)
+	assert.Equal(t, "data-raycluster-sample-worker-group-2", pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	assert.NotNil(t, pod.Spec.Volumes[1].EmptyDir)
+	assert.Equal(t, "cache-raycluster-sample-worker-group-2", pod.Spec.Volumes[2].PersistentVolumeClaim.ClaimName)
+
+	claimName, ok := GetVolumeClaimName(pod, "cache")
+	assert.True(t, ok)
+	assert.Equal(t, "cache-raycluster-sample-worker-group-2", claimName)
+	_, ok = GetVolumeClaimName(pod, "logs")
+	assert.False(t, ok)
+}
+
+func TestBuildVolumeClaim(t *testing.T) {
+	cluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "raycluster-sample", Namespace: "default"},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "raycluster-sample-worker-abcde",
+			Namespace: "default",
+			Labels: map[string]string{
+				utils.RayNodeGroupLabelKey:   "worker-group",
+				utils.RayNodeTypeLabelKey:    string(rayv1.WorkerNode),
+				utils.RayVolumeClaimIndexKey: "0",
+			},
+		},
+	}
+	template := corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "data",
+			Labels:      map[string]string{"app": "ray"},
+			Annotations: map[string]string{"key": "value"},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+			},
+		},
+	}
+
+	pvc := BuildVolumeClaim(cluster, template, pod, "data-raycluster-sample-worker-group-0")
+
+	assert.Equal(t, "data-raycluster-sample-worker-group-0", pvc.Name)
+	assert.Equal(t, "default", pvc.Namespace)
+	assert.Equal(t, map[string]string{
+		"app":                                   "ray",
+		utils.RayClusterLabelKey:                "raycluster-sample",
+		utils.RayNodeGroupLabelKey:              "worker-group",
+		utils.RayNodeTypeLabelKey:               string(rayv1.WorkerNode),
+		utils.RayVolumeClaimIndexKey:            "0",
+		utils.KubernetesApplicationNameLabelKey: utils.ApplicationName,
+		utils.KubernetesCreatedByLabelKey:       utils.ComponentName,
+	}, pvc.Labels)
+	assert.Equal(t, template.Annotations, pvc.Annotations)
+	assert.Equal(t, template.Spec, pvc.Spec)
+	// The template labels are not modified.
+	assert.Equal(t, map[string]string{"app": "ray"}, template.Labels)
+}
@@ -26,7 +26,7 @@ func BuildRouteForHeadService(cluster rayv1.RayCluster) (*routev1.Route, error)
 		annotation[key] = value
 	}
 
-	servicePorts := getServicePorts(cluster)
+	servicePorts := GetServicePorts(cluster)
 	dashboardPort := utils.DefaultDashboardPort
 	if port, ok := servicePorts["dashboard"]; ok {
 		dashboardPort = int(port)
@@ -70,7 +70,7 @@ func BuildServiceForHeadPod(ctx context.Context, cluster rayv1.RayCluster, label
 
 	defaultAppProtocol := utils.DefaultServiceAppProtocol
 	// `portsInt` is a map of port names to port numbers, while `ports` is a list of ServicePort objects
-	portsInt := getServicePorts(cluster)
+	portsInt := GetServicePorts(cluster)
 	ports := []corev1.ServicePort{}
 	for name, port := range portsInt {
 		svcPort := corev1.ServicePort{Name: name, Port: port, AppProtocol: &defaultAppProtocol}
@@ -210,7 +210,7 @@ func BuildServeService(ctx context.Context, rayService rayv1.RayService, rayClus
 	}
 
 	// `portsInt` is a map of port names to port numbers, while `ports` is a list of ServicePort objects
-	portsInt := getServicePorts(rayCluster)
+	portsInt := GetServicePorts(rayCluster)
 	ports := make([]corev1.ServicePort, 0, 1)
 	if _, defined := portsInt[utils.ServingPortName]; defined {
 		// Only include serve port
@@ -287,7 +287,7 @@ func BuildServeService(ctx context.Context, rayService rayv1.RayService, rayClus
 
 // BuildHeadlessService builds the headless service for workers in multi-host worker groups to communicate
 func BuildHeadlessServiceForRayCluster(rayCluster rayv1.RayCluster) *corev1.Service {
-	name := rayCluster.Name + utils.DashSymbol + utils.HeadlessServiceSuffix
+	name := utils.GenerateHeadlessServiceName(rayCluster.Name)
 	namespace := rayCluster.Namespace
 
 	labels := map[string]string{
@@ -371,8 +371,9 @@ func setLabelsforUserProvidedService(service *corev1.Service, labels map[string]
 	}
 }
 
-// getServicePorts will either user passing ports or default ports to create service.
-func getServicePorts(cluster rayv1.RayCluster) map[string]int32 {
+// GetServicePorts will either user passing ports or default ports to create service.
+// The NetworkPolicies of the RayCluster also allow these ports.
+func GetServicePorts(cluster rayv1.RayCluster) map[string]int32 {
 	ports := getPortsFromCluster(cluster)
 
 	// Assign default ports
@@ -385,6 +386,14 @@ func getServicePorts(cluster rayv1.RayCluster) map[string]int32 {
 		ports[utils.MetricsPortName] = utils.DefaultMetricsPort
 	}
 
+	// Expose the auth proxy listeners in front of the Serve HTTP endpoint and the Ray client port.
+	if utils.IsServeAuthProxyEnabled(&cluster) {
+		ports[utils.ServeAuthProxyPortName] = utils.DefaultServeAuthProxyPort
+	}
+	if utils.IsClientAuthProxyEnabled(&cluster) {
+		ports[utils.ClientAuthProxyPortName] = utils.DefaultClientAuthProxyPort
+	}
+
 	return ports
 }
 
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
@@ -282,12 +283,29 @@ func TestGetServicePortsWithMetricsPort(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			cluster := instanceWithWrongSvc.DeepCopy()
 			cluster.Spec.HeadGroupSpec.Template.Spec.Containers[0].Ports = testCase.ports
-			ports := getServicePorts(*cluster)
+			ports := GetServicePorts(*cluster)
 			assert.Equal(t, testCase.expectResult, ports[utils.MetricsPortName])
 		})
 	}
 }
 
+func TestGetServicePortsAuthProxyListeners(t *testing.T) {
+	cluster := instanceWithWrongSvc.DeepCopy()
+	ports := GetServicePorts(*cluster)
+	assert.NotContains(t, ports, utils.ServeAuthProxyPortName)
+	assert.NotContains(t, ports, utils.ClientAuthProxyPortName)
+
+	// The listeners of the auth proxy are exposed by the head service.
+	cluster.Spec.SecurityOptions = &rayv1.SecurityOptions{
+		AuthProxy:       ptr.To(true),
+		ServeAuthProxy:  ptr.To(true),
+		ClientAuthProxy: ptr.To(true),
+	}
+	ports = GetServicePorts(*cluster)
+	assert.Equal(t, int32(utils.DefaultServeAuthProxyPort), ports[utils.ServeAuthProxyPortName])
+	assert.Equal(t, int32(utils.DefaultClientAuthProxyPort), ports[utils.ClientAuthProxyPortName])
+}
+
 func TestUserSpecifiedHeadService(t *testing.T) {
 	// Use any RayCluster instance as a base for the test.
 	testRayClusterWithHeadService := instanceWithWrongSvc.DeepCopy()
@@ -24,6 +24,7 @@ type RayClusterMetricsManager struct {
 	rayClusterProvisionedDurationSeconds *prometheus.GaugeVec
 	rayClusterInfo                       *prometheus.Desc
 	rayClusterConditionProvisioned       *prometheus.Desc
+	rayClusterCertificateNotAfter        *prometheus.Desc
 	client                               client.Client
 	log                                  logr.Logger
 }
@@ -56,6 +57,14 @@ func NewRayClusterMetricsManager(ctx context.Context, client client.Client) *Ray
 			[]string{"name", "namespace", "condition"},
 			nil,
 		),
+		// rayClusterCertificateNotAfter is a gauge metric that indicates when the first mTLS certificate of a
+		// RayCluster expires, as a Unix timestamp. It is only reported for RayClusters with mTLS certificates.
+		rayClusterCertificateNotAfter: prometheus.NewDesc(
+			"kuberay_cluster_certificate_not_after_seconds",
+			"The time, as a Unix timestamp in seconds, when the first mTLS certificate of the RayCluster expires",
+			[]string{"name", "namespace"},
+			nil,
+		),
 		client: client,
 		log:    ctrl.LoggerFrom(ctx),
 	}
@@ -66,6 +75,7 @@ func NewRayClusterMetricsManager(ctx context.Context, client client.Client) *Ray
 func (r *RayClusterMetricsManager) Describe(ch chan<- *prometheus.Desc) {
 	r.rayClusterProvisionedDurationSeconds.Describe(ch)
 	ch <- r.rayClusterInfo
+	ch <- r.rayClusterCertificateNotAfter
 }
 
 // Collect implements prometheus.Collector interface Collect method.
@@ -82,6 +92,7 @@ func (r *RayClusterMetricsManager) Collect(ch chan<- prometheus.Metric) {
 	for _, rayCluster := range rayClusterList.Items {
 		r.collectRayClusterInfo(&rayCluster, ch)
 		r.collectRayClusterConditionProvisioned(&rayCluster, ch)
+		r.collectRayClusterCertificateNotAfter(&rayCluster, ch)
 	}
 }
 
@@ -115,3 +126,17 @@ func (r *RayClusterMetricsManager) collectRayClusterConditionProvisioned(cluster
 		strconv.FormatBool(meta.IsStatusConditionTrue(cluster.Status.Conditions, string(rayv1.RayClusterProvisioned))),
 	)
 }
+
+func (r *RayClusterMetricsManager) collectRayClusterCertificateNotAfter(cluster *rayv1.RayCluster, ch chan<- prometheus.Metric) {
+	if cluster.Status.Certificates == nil || cluster.Status.Certificates.NotAfter == nil {
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(
+		r.rayClusterCertificateNotAfter,
+		prometheus.GaugeValue,
+		float64(cluster.Status.Certificates.NotAfter.Unix()),
+		cluster.Name,
+		cluster.Namespace,
+	)
+}
@@ -185,3 +185,41 @@ func TestRayClusterConditionProvisioned(t *testing.T) {
 		})
 	}
 }
+
+func TestRayClusterCertificateNotAfter(t *testing.T) {
+	notAfter := metav1.Unix(1798761600, 0)
+	clusters := []rayv1.RayCluster{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mtls-cluster",
+				Namespace: "default",
+			},
+			Status: rayv1.RayClusterStatus{
+				Certificates: &rayv1.CertificatesStatus{NotAfter: &notAfter},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "plain-cluster",
+				Namespace: "default",
+			},
+		},
+	}
+
+	k8sScheme := runtime.NewScheme()
+	require.NoError(t, rayv1.AddToScheme(k8sScheme))
+	client := fake.NewClientBuilder().WithScheme(k8sScheme).WithObjects(&clusters[0], &clusters[1]).Build()
+	manager := NewRayClusterMetricsManager(context.Background(), client)
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(manager)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "/metrics", nil)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	promhttp.HandlerFor(reg, promhttp.HandlerOpts{}).ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	body := rr.Body.String()
+	assert.Contains(t, body, `kuberay_cluster_certificate_not_after_seconds{name="mtls-cluster",namespace="default"} 1.7987616e+09`)
+	assert.NotContains(t, body, `kuberay_cluster_certificate_not_after_seconds{name="plain-cluster"`)
+}
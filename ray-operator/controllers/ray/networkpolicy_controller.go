@@ -3,26 +3,41 @@ package ray
 import (
 	"context"
 	"fmt"
+	"net"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
 
 	routev1 "github.com/openshift/api/route/v1"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	configapi "github.com/ray-project/kuberay/ray-operator/apis/config/v1alpha1"
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/common"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
 )
 
+// kubernetesAPIServiceName is the name of the Service of the Kubernetes API in the default namespace
+const kubernetesAPIServiceName = "kubernetes"
+
 // NetworkPolicyController is a completely independent controller that watches RayCluster
 // resources and manages NetworkPolicies for them.
 type NetworkPolicyController struct {
@@ -30,20 +45,24 @@ type NetworkPolicyController struct {
 	Scheme     *runtime.Scheme
 	Recorder   record.EventRecorder
 	RESTMapper meta.RESTMapper
+	// MonitoringNamespace is the namespace allowed to scrape the metrics ports of the Ray Pods
+	MonitoringNamespace string
 }
 
 // NewNetworkPolicyController creates a new independent NetworkPolicy controller
-func NewNetworkPolicyController(mgr manager.Manager) *NetworkPolicyController {
+func NewNetworkPolicyController(mgr manager.Manager, config *configapi.Configuration) *NetworkPolicyController {
 	return &NetworkPolicyController{
-		Client:     mgr.GetClient(),
-		Scheme:     mgr.GetScheme(),
-		Recorder:   mgr.GetEventRecorderFor("networkpolicy-controller"),
-		RESTMapper: mgr.GetRESTMapper(),
+		Client:              mgr.GetClient(),
+		Scheme:              mgr.GetScheme(),
+		Recorder:            mgr.GetEventRecorderFor("networkpolicy-controller"),
+		RESTMapper:          mgr.GetRESTMapper(),
+		MonitoringNamespace: config.MonitoringNamespace,
 	}
 }
 
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;update;delete;patch
 // +kubebuilder:rbac:groups=ray.io,resources=rayclusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=get;list;watch
 
 // Reconcile handles RayCluster resources and creates/manages NetworkPolicies
 func (r *NetworkPolicyController) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -66,7 +85,7 @@ func (r *NetworkPolicyController) Reconcile(ctx context.Context, req ctrl.Reques
 		return ctrl.Result{}, nil
 	}
 
-	// Check if NetworkPolicy is enabled via annotation
+	// Check if NetworkPolicy is enabled via security options or annotation
 	if !r.isSecureTrustedNetworkEnabled(instance) {
 		logger.V(1).Info("NetworkPolicy not enabled for RayCluster", "cluster", instance.Name,
 			"annotation", utils.EnableSecureTrustedNetworkAnnotationKey)
@@ -74,6 +93,16 @@ func (r *NetworkPolicyController) Reconcile(ctx context.Context, req ctrl.Reques
 		return r.cleanupNetworkPoliciesIfNeeded(ctx, instance)
 	}
 
+	// The RayCluster controller does not create the Ray Pods of a RayCluster with invalid rules either
+	if instance.Spec.NetworkPolicy != nil {
+		if err := utils.ValidateNetworkPolicyOptions(instance.Spec.NetworkPolicy); err != nil {
+			logger.Info("Skipping NetworkPolicies with invalid rules", "cluster", instance.Name, "error", err.Error())
+			r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.InvalidRayClusterSpec),
+				"Invalid networkPolicy: %v", err)
+			return ctrl.Result{}, nil
+		}
+	}
+
 	logger.Info("Reconciling NetworkPolicies for RayCluster", "cluster", instance.Name)
 
 	// Get KubeRay operator namespaces
@@ -190,13 +219,26 @@ func (r *NetworkPolicyController) buildHeadNetworkPolicy(ctx context.Context, in
 		utils.KubernetesCreatedByLabelKey:       utils.ComponentName,
 	}
 
-	// Build secured ports - only mTLS port 8443 should be accessible from anywhere
-	// Port 10001 is already covered by Rules 1-3 (intra-cluster, same-namespace, operator)
-	allSecuredPorts := []networkingv1.NetworkPolicyPort{
-		{
-			Protocol: &[]corev1.Protocol{corev1.ProtocolTCP}[0],
-			Port:     &[]intstr.IntOrString{intstr.FromInt(8443)}[0],
-		},
+	// Derive the ports from the same discovery as the head Service, so that ports changed through the
+	// container ports of the head Pod are not blocked
+	servicePorts := common.GetServicePorts(*instance)
+	dashboardPort := servicePortOrDefault(servicePorts, utils.DashboardPortName, utils.DefaultDashboardPort)
+	clientPort := servicePortOrDefault(servicePorts, utils.ClientPortName, utils.DefaultClientPort)
+	metricsPort := servicePortOrDefault(servicePorts, utils.MetricsPortName, utils.DefaultMetricsPort)
+
+	// Build secured ports - only the auth proxy listeners should be accessible from anywhere
+	// The client port is already covered by Rules 1-3 (intra-cluster, same-namespace, operator)
+	allSecuredPorts := []networkingv1.NetworkPolicyPort{tcpNetworkPolicyPort(authProxyPort)}
+
+	// Pods of the namespace reach the Ray client port through the TLS listener of the auth proxy if it is enabled
+	namespacePorts := []networkingv1.NetworkPolicyPort{tcpNetworkPolicyPort(dashboardPort)}
+	if utils.IsServeAuthProxyEnabled(instance) {
+		allSecuredPorts = append(allSecuredPorts, tcpNetworkPolicyPort(servicePorts[utils.ServeAuthProxyPortName]))
+	}
+	if utils.IsClientAuthProxyEnabled(instance) {
+		allSecuredPorts = append(allSecuredPorts, tcpNetworkPolicyPort(servicePorts[utils.ClientAuthProxyPortName]))
+	} else {
+		namespacePorts = append(namespacePorts, tcpNetworkPolicyPort(clientPort))
 	}
 
 	// Build ingress rules
@@ -223,16 +265,7 @@ func (r *NetworkPolicyController) buildHeadNetworkPolicy(ctx context.Context, in
 					},
 				},
 			},
-			Ports: []networkingv1.NetworkPolicyPort{
-				{
-					Protocol: &[]corev1.Protocol{corev1.ProtocolTCP}[0],
-					Port:     &[]intstr.IntOrString{intstr.FromInt(10001)}[0], // Client
-				},
-				{
-					Protocol: &[]corev1.Protocol{corev1.ProtocolTCP}[0],
-					Port:     &[]intstr.IntOrString{intstr.FromInt(8265)}[0], // Dashboard
-				},
-			},
+			Ports: namespacePorts,
 		},
 		// Rule 3: KubeRay operator access
 		{
@@ -255,14 +288,8 @@ func (r *NetworkPolicyController) buildHeadNetworkPolicy(ctx context.Context, in
 				},
 			},
 			Ports: []networkingv1.NetworkPolicyPort{
-				{
-					Protocol: &[]corev1.Protocol{corev1.ProtocolTCP}[0],
-					Port:     &[]intstr.IntOrString{intstr.FromInt(8265)}[0], // Dashboard
-				},
-				{
-					Protocol: &[]corev1.Protocol{corev1.ProtocolTCP}[0],
-					Port:     &[]intstr.IntOrString{intstr.FromInt(10001)}[0], // Client
-				},
+				tcpNetworkPolicyPort(dashboardPort),
+				tcpNetworkPolicyPort(clientPort),
 			},
 		},
 		// Rule 5: Secured ports - NO FROM (allows all)
@@ -272,34 +299,14 @@ func (r *NetworkPolicyController) buildHeadNetworkPolicy(ctx context.Context, in
 		},
 	}
 
-	// Rule 4: Monitoring access (optional, set by ODH operator via MONITORING_NAMESPACE env var)
-	if monitoringNamespace := os.Getenv("MONITORING_NAMESPACE"); monitoringNamespace != "" {
+	// Rule 4: Monitoring access (optional, set in the operator configuration or by ODH operator via MONITORING_NAMESPACE env var)
+	if monitoringNamespace := r.getMonitoringNamespace(); monitoringNamespace != "" {
 		logger.V(1).Info("Adding monitoring access rule", "namespace", monitoringNamespace)
-		monitoringRule := networkingv1.NetworkPolicyIngressRule{
-			From: []networkingv1.NetworkPolicyPeer{
-				{
-					NamespaceSelector: &metav1.LabelSelector{
-						MatchExpressions: []metav1.LabelSelectorRequirement{
-							{
-								Key:      corev1.LabelMetadataName,
-								Operator: metav1.LabelSelectorOpIn,
-								Values:   []string{monitoringNamespace},
-							},
-						},
-					},
-				},
-			},
-			Ports: []networkingv1.NetworkPolicyPort{
-				{
-					Protocol: &[]corev1.Protocol{corev1.ProtocolTCP}[0],
-					Port:     &[]intstr.IntOrString{intstr.FromInt(8080)}[0], // Metrics
-				},
-			},
-		}
+		monitoringRule := buildMonitoringRule(monitoringNamespace, []int32{metricsPort})
 		// Insert monitoring rule before secured ports rule (which is now last)
 		ingressRules = append(ingressRules[:len(ingressRules)-1], monitoringRule, ingressRules[len(ingressRules)-1])
 	} else {
-		logger.V(1).Info("Skipping monitoring access rule - monitoring namespace not configured in DSCI")
+		logger.V(1).Info("Skipping monitoring access rule - monitoring namespace not configured")
 	}
 
 	// Add RayJob submitter peer if RayCluster is owned by RayJob
@@ -309,6 +316,22 @@ func (r *NetworkPolicyController) buildHeadNetworkPolicy(ctx context.Context, in
 		})
 	}
 
+	// Add the user-defined rules after the generated ones
+	if instance.Spec.NetworkPolicy != nil {
+		ingressRules = append(ingressRules, instance.Spec.NetworkPolicy.HeadIngress...)
+	}
+
+	policyTypes := []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}
+	egressRules := r.buildEgressRules(instance)
+	if egressRules != nil {
+		policyTypes = append(policyTypes, networkingv1.PolicyTypeEgress)
+		// The autoscaler and the auth proxy in the head Pod call the Kubernetes API
+		egressRules = append(egressRules, r.buildKubernetesAPIEgressRule(ctx))
+		if redisRule := r.buildRedisEgressRule(ctx, instance); redisRule != nil {
+			egressRules = append(egressRules, *redisRule)
+		}
+	}
+
 	return &networkingv1.NetworkPolicy{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      fmt.Sprintf("%s-head", instance.Name),
@@ -322,8 +345,9 @@ func (r *NetworkPolicyController) buildHeadNetworkPolicy(ctx context.Context, in
 					utils.RayNodeTypeLabelKey: string(rayv1.HeadNode),
 				},
 			},
-			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			PolicyTypes: policyTypes,
 			Ingress:     ingressRules,
+			Egress:      egressRules,
 		},
 	}
 }
@@ -336,6 +360,37 @@ func (r *NetworkPolicyController) buildWorkerNetworkPolicy(instance *rayv1.RayCl
 		utils.KubernetesCreatedByLabelKey:       utils.ComponentName,
 	}
 
+	ingressRules := []networkingv1.NetworkPolicyIngressRule{
+		// Intra-cluster communication - NO PORTS (allows all ports)
+		{
+			From: []networkingv1.NetworkPolicyPeer{
+				{
+					PodSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							utils.RayClusterLabelKey: instance.Name,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// Monitoring access to the metrics ports of the worker groups
+	if monitoringNamespace := r.getMonitoringNamespace(); monitoringNamespace != "" {
+		ingressRules = append(ingressRules, buildMonitoringRule(monitoringNamespace, workerMetricsPorts(instance)))
+	}
+
+	// Add the user-defined rules after the generated ones
+	if instance.Spec.NetworkPolicy != nil {
+		ingressRules = append(ingressRules, instance.Spec.NetworkPolicy.WorkerIngress...)
+	}
+
+	policyTypes := []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}
+	egressRules := r.buildEgressRules(instance)
+	if egressRules != nil {
+		policyTypes = append(policyTypes, networkingv1.PolicyTypeEgress)
+	}
+
 	return &networkingv1.NetworkPolicy{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      fmt.Sprintf("%s-workers", instance.Name),
@@ -349,22 +404,269 @@ func (r *NetworkPolicyController) buildWorkerNetworkPolicy(instance *rayv1.RayCl
 					utils.RayNodeTypeLabelKey: string(rayv1.WorkerNode),
 				},
 			},
-			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
-			Ingress: []networkingv1.NetworkPolicyIngressRule{
-				{
-					From: []networkingv1.NetworkPolicyPeer{
+			PolicyTypes: policyTypes,
+			Ingress:     ingressRules,
+			Egress:      egressRules,
+		},
+	}
+}
+
+// getMonitoringNamespace returns the namespace of the Prometheus that scrapes the Ray Pods
+// Operator configuration → MONITORING_NAMESPACE (set by ODH operator)
+func (r *NetworkPolicyController) getMonitoringNamespace() string {
+	if r.MonitoringNamespace != "" {
+		return r.MonitoringNamespace
+	}
+	return os.Getenv("MONITORING_NAMESPACE")
+}
+
+// buildMonitoringRule creates an ingress rule that allows the metrics ports from the monitoring namespace
+func buildMonitoringRule(monitoringNamespace string, metricsPorts []int32) networkingv1.NetworkPolicyIngressRule {
+	rule := networkingv1.NetworkPolicyIngressRule{
+		From: []networkingv1.NetworkPolicyPeer{
+			{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
 						{
-							PodSelector: &metav1.LabelSelector{
-								MatchLabels: map[string]string{
-									utils.RayClusterLabelKey: instance.Name,
-								},
-							},
+							Key:      corev1.LabelMetadataName,
+							Operator: metav1.LabelSelectorOpIn,
+							Values:   []string{monitoringNamespace},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, port := range metricsPorts {
+		rule.Ports = append(rule.Ports, tcpNetworkPolicyPort(port))
+	}
+	return rule
+}
+
+// workerMetricsPorts returns the distinct metrics ports of the worker groups
+// A worker group uses the default metrics port unless its Ray container declares a port named "metrics"
+func workerMetricsPorts(instance *rayv1.RayCluster) []int32 {
+	ports := []int32{}
+	for _, workerGroup := range instance.Spec.WorkerGroupSpecs {
+		port := int32(utils.DefaultMetricsPort)
+		if containers := workerGroup.Template.Spec.Containers; len(containers) > utils.RayContainerIndex {
+			for _, containerPort := range containers[utils.RayContainerIndex].Ports {
+				if containerPort.Name == utils.MetricsPortName {
+					port = containerPort.ContainerPort
+				}
+			}
+		}
+		if !slices.Contains(ports, port) {
+			ports = append(ports, port)
+		}
+	}
+	if len(ports) == 0 {
+		ports = append(ports, utils.DefaultMetricsPort)
+	}
+	return ports
+}
+
+// servicePortOrDefault returns the port of the head Service with the given name, or the default port of Ray
+// if the head container does not declare it
+func servicePortOrDefault(servicePorts map[string]int32, name string, defaultPort int32) int32 {
+	if port, ok := servicePorts[name]; ok {
+		return port
+	}
+	return defaultPort
+}
+
+// tcpNetworkPolicyPort returns a NetworkPolicy port for the given TCP port
+func tcpNetworkPolicyPort(port int32) networkingv1.NetworkPolicyPort {
+	return networkingv1.NetworkPolicyPort{
+		Protocol: &[]corev1.Protocol{corev1.ProtocolTCP}[0],
+		Port:     &[]intstr.IntOrString{intstr.FromInt32(port)}[0],
+	}
+}
+
+// buildEgressRules creates the egress rules shared by the head and worker NetworkPolicies
+// Returns nil if the egress traffic of the RayCluster is not restricted
+func (r *NetworkPolicyController) buildEgressRules(instance *rayv1.RayCluster) []networkingv1.NetworkPolicyEgressRule {
+	if instance.Spec.NetworkPolicy == nil || instance.Spec.NetworkPolicy.Egress == nil {
+		return nil
+	}
+
+	// DNS - NO TO (the DNS server may run on the host network)
+	dnsPorts := []int{utils.DefaultDNSPort}
+	if r.isOpenShift() {
+		dnsPorts = append(dnsPorts, utils.OpenShiftDNSPort)
+	}
+	dnsRule := networkingv1.NetworkPolicyEgressRule{}
+	for _, port := range dnsPorts {
+		dnsRule.Ports = append(dnsRule.Ports,
+			networkingv1.NetworkPolicyPort{
+				Protocol: &[]corev1.Protocol{corev1.ProtocolUDP}[0],
+				Port:     &[]intstr.IntOrString{intstr.FromInt(port)}[0],
+			},
+			networkingv1.NetworkPolicyPort{
+				Protocol: &[]corev1.Protocol{corev1.ProtocolTCP}[0],
+				Port:     &[]intstr.IntOrString{intstr.FromInt(port)}[0],
+			},
+		)
+	}
+
+	egressRules := []networkingv1.NetworkPolicyEgressRule{
+		// Intra-cluster communication - NO PORTS (allows all ports)
+		{
+			To: []networkingv1.NetworkPolicyPeer{
+				{
+					PodSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							utils.RayClusterLabelKey: instance.Name,
 						},
 					},
 				},
 			},
 		},
+		dnsRule,
 	}
+	return append(egressRules, instance.Spec.NetworkPolicy.Egress.Allow...)
+}
+
+// buildKubernetesAPIEgressRule creates an egress rule to the endpoints of the kubernetes Service
+// Falls back to the Kubernetes API ports to any destination if the endpoints cannot be read
+func (r *NetworkPolicyController) buildKubernetesAPIEgressRule(ctx context.Context) networkingv1.NetworkPolicyEgressRule {
+	logger := ctrl.LoggerFrom(ctx).WithName("networkpolicy-controller")
+
+	// NetworkPolicies apply after the Service IP is translated, so the rule must match the API servers themselves
+	endpointSlices := &discoveryv1.EndpointSliceList{}
+	rule := networkingv1.NetworkPolicyEgressRule{}
+	if err := r.List(ctx, endpointSlices, client.InNamespace(metav1.NamespaceDefault),
+		client.MatchingLabels{discoveryv1.LabelServiceName: kubernetesAPIServiceName}); err == nil {
+		var ports []int32
+		for _, endpointSlice := range endpointSlices.Items {
+			for _, endpoint := range endpointSlice.Endpoints {
+				if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+					continue
+				}
+				for _, address := range endpoint.Addresses {
+					rule.To = append(rule.To, networkingv1.NetworkPolicyPeer{
+						IPBlock: &networkingv1.IPBlock{CIDR: ipToCIDR(address)},
+					})
+				}
+			}
+			for _, port := range endpointSlice.Ports {
+				if port.Port != nil && !slices.Contains(ports, *port.Port) {
+					ports = append(ports, *port.Port)
+				}
+			}
+		}
+		for _, port := range ports {
+			rule.Ports = append(rule.Ports, networkingv1.NetworkPolicyPort{
+				Protocol: &[]corev1.Protocol{corev1.ProtocolTCP}[0],
+				Port:     &[]intstr.IntOrString{intstr.FromInt32(port)}[0],
+			})
+		}
+	} else {
+		logger.Info("Failed to read the endpoints of the Kubernetes API, allowing its default ports", "error", err.Error())
+	}
+
+	if len(rule.To) == 0 || len(rule.Ports) == 0 {
+		return networkingv1.NetworkPolicyEgressRule{
+			Ports: []networkingv1.NetworkPolicyPort{
+				{
+					Protocol: &[]corev1.Protocol{corev1.ProtocolTCP}[0],
+					Port:     &[]intstr.IntOrString{intstr.FromInt(utils.DefaultKubernetesAPIPort)}[0],
+				},
+				{
+					Protocol: &[]corev1.Protocol{corev1.ProtocolTCP}[0],
+					Port:     &[]intstr.IntOrString{intstr.FromInt(utils.KubernetesAPIServerPort)}[0],
+				},
+			},
+		}
+	}
+	return rule
+}
+
+// buildRedisEgressRule creates an egress rule to the Redis of GCS fault tolerance
+// Returns nil if GCS fault tolerance is not configured with GcsFaultToleranceOptions
+func (r *NetworkPolicyController) buildRedisEgressRule(ctx context.Context, instance *rayv1.RayCluster) *networkingv1.NetworkPolicyEgressRule {
+	logger := ctrl.LoggerFrom(ctx).WithName("networkpolicy-controller")
+
+	options := instance.Spec.GcsFaultToleranceOptions
+	if options == nil || options.RedisAddress == "" {
+		return nil
+	}
+	host, port, err := parseRedisAddress(options.RedisAddress)
+	if err != nil {
+		logger.Info("Skipping the egress rule to Redis", "address", options.RedisAddress, "error", err.Error())
+		return nil
+	}
+
+	rule := &networkingv1.NetworkPolicyEgressRule{
+		Ports: []networkingv1.NetworkPolicyPort{
+			{
+				Protocol: &[]corev1.Protocol{corev1.ProtocolTCP}[0],
+				Port:     &[]intstr.IntOrString{intstr.FromInt32(port)}[0],
+			},
+		},
+	}
+	if net.ParseIP(host) != nil {
+		rule.To = []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: ipToCIDR(host)}}}
+	} else if namespace, ok := serviceNamespace(host, instance.Namespace); ok {
+		rule.To = []networkingv1.NetworkPolicyPeer{
+			{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						corev1.LabelMetadataName: namespace,
+					},
+				},
+			},
+		}
+	}
+	// Other host names are resolved outside of the cluster - NO TO (allows the port to any destination)
+	return rule
+}
+
+// parseRedisAddress returns the host and port of a Redis address such as "redis:6379" or "rediss://user@redis:6380/0"
+func parseRedisAddress(address string) (string, int32, error) {
+	if i := strings.Index(address, "://"); i >= 0 {
+		address = address[i+len("://"):]
+	}
+	if i := strings.LastIndex(address, "@"); i >= 0 {
+		address = address[i+1:]
+	}
+	if i := strings.Index(address, "/"); i >= 0 {
+		address = address[:i]
+	}
+	if address == "" {
+		return "", 0, fmt.Errorf("missing host")
+	}
+
+	host, portStr, err := net.SplitHostPort(address)
+	if err != nil {
+		// The address has no port
+		host, portStr = strings.Trim(address, "[]"), strconv.Itoa(utils.DefaultRedisPort)
+	}
+	port, err := strconv.ParseInt(portStr, 10, 32)
+	if err != nil || port < 1 || port > 65535 {
+		return "", 0, fmt.Errorf("invalid port %q", portStr)
+	}
+	return host, int32(port), nil
+}
+
+// serviceNamespace returns the namespace of a Service host name such as "redis", "redis.db.svc" or "redis.db.svc.cluster.local"
+func serviceNamespace(host string, defaultNamespace string) (string, bool) {
+	labels := strings.Split(host, ".")
+	if len(labels) == 1 {
+		return defaultNamespace, true
+	}
+	if len(labels) >= 3 && labels[2] == "svc" {
+		return labels[1], true
+	}
+	return "", false
+}
+
+// ipToCIDR returns the CIDR that only contains the given IP
+func ipToCIDR(ip string) string {
+	if strings.Contains(ip, ":") {
+		return ip + "/128"
+	}
+	return ip + "/32"
 }
 
 // buildRayJobPeer creates a NetworkPolicy peer for RayJob submitter pods
@@ -392,30 +694,56 @@ func (r *NetworkPolicyController) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&rayv1.RayCluster{}).
 		Owns(&networkingv1.NetworkPolicy{}).
+		// The egress rule to the Kubernetes API lists the API servers, which may be replaced or scaled
+		Watches(&discoveryv1.EndpointSlice{}, handler.EnqueueRequestsFromMapFunc(mapKubernetesAPIEndpointsToRayClusters(r)),
+			builder.WithPredicates(predicate.NewPredicateFuncs(isKubernetesAPIEndpointSlice))).
 		Named("networkpolicy").
 		Complete(r)
 }
 
-// isSecuredTrustedNetworkEnabled checks if NetworkPolicy is enabled for this RayCluster via annotation
-func (r *NetworkPolicyController) isSecureTrustedNetworkEnabled(instance *rayv1.RayCluster) bool {
-	if instance.Annotations == nil {
-		return false
-	}
+// isKubernetesAPIEndpointSlice returns whether the object is an EndpointSlice of the kubernetes Service
+func isKubernetesAPIEndpointSlice(obj client.Object) bool {
+	return obj.GetNamespace() == metav1.NamespaceDefault && obj.GetLabels()[discoveryv1.LabelServiceName] == kubernetesAPIServiceName
+}
 
-	value, exists := instance.Annotations[utils.EnableSecureTrustedNetworkAnnotationKey]
-	if !exists {
-		return false
-	}
+// mapKubernetesAPIEndpointsToRayClusters maps changes of the endpoints of the Kubernetes API to the RayClusters
+// whose NetworkPolicies restrict egress, so that their egress rule to the API servers is kept up to date
+func mapKubernetesAPIEndpointsToRayClusters(c client.Reader) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		logger := ctrl.LoggerFrom(ctx)
+
+		rayClusterList := &rayv1.RayClusterList{}
+		if err := c.List(ctx, rayClusterList); err != nil {
+			logger.Error(err, "Failed to list RayClusters for Kubernetes API endpoints mapping")
+			return []reconcile.Request{}
+		}
 
-	// Accept "true", "1", "yes", "on" as truthy values (case-insensitive)
-	switch value {
-	case "true", "1", "yes", "on", "True", "TRUE", "Yes", "YES", "On", "ON":
-		return true
-	default:
-		return false
+		requests := make([]reconcile.Request, 0)
+		for _, cluster := range rayClusterList.Items {
+			if !utils.IsNetworkPolicyEnabled(&cluster) || cluster.Spec.NetworkPolicy == nil || cluster.Spec.NetworkPolicy.Egress == nil {
+				continue
+			}
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      cluster.Name,
+					Namespace: cluster.Namespace,
+				},
+			})
+		}
+
+		logger.Info("Mapping Kubernetes API endpoints change to RayClusters",
+			"endpointSlice", obj.GetName(),
+			"clusters", len(requests))
+
+		return requests
 	}
 }
 
+// isSecureTrustedNetworkEnabled checks if NetworkPolicy is enabled for this RayCluster via its security options or annotation
+func (r *NetworkPolicyController) isSecureTrustedNetworkEnabled(instance *rayv1.RayCluster) bool {
+	return utils.IsNetworkPolicyEnabled(instance)
+}
+
 // cleanupNetworkPoliciesIfNeeded removes NetworkPolicies if they exist but annotation is disabled
 func (r *NetworkPolicyController) cleanupNetworkPoliciesIfNeeded(ctx context.Context, instance *rayv1.RayCluster) (ctrl.Result, error) {
 	logger := ctrl.LoggerFrom(ctx).WithName("networkpolicy-controller")
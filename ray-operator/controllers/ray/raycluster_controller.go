@@ -4,18 +4,22 @@ import (
 	"context"
 	errstd "errors"
 	"fmt"
+	"maps"
 	"os"
 	"reflect"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
+	configv1 "github.com/openshift/api/config/v1"
 	routev1 "github.com/openshift/api/route/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -29,6 +33,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -36,6 +41,7 @@ import (
 	configapi "github.com/ray-project/kuberay/ray-operator/apis/config/v1alpha1"
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler"
+	schedulerinterface "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/interface"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/common"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/expectations"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/metrics"
@@ -77,6 +83,7 @@ func NewReconciler(ctx context.Context, mgr manager.Manager, options RayClusterR
 		Recorder:                   mgr.GetEventRecorderFor("raycluster-controller"),
 		BatchSchedulerMgr:          schedulerMgr,
 		rayClusterScaleExpectation: expectations.NewRayClusterScaleExpectation(mgr.GetClient()),
+		dashboardClientFunc:        rayConfigs.GetDashboardClient(mgr),
 		options:                    options,
 	}
 }
@@ -88,6 +95,7 @@ type RayClusterReconciler struct {
 	Recorder                   record.EventRecorder
 	BatchSchedulerMgr          *batchscheduler.SchedulerManager
 	rayClusterScaleExpectation expectations.RayClusterScaleExpectation
+	dashboardClientFunc        func() utils.RayDashboardClientInterface
 	options                    RayClusterReconcilerOptions
 }
 
@@ -96,6 +104,12 @@ type RayClusterReconcilerOptions struct {
 	HeadSidecarContainers    []corev1.Container
 	WorkerSidecarContainers  []corev1.Container
 	IsOpenShift              bool
+	// MTLSCertificateIssuer is the default issuer of the mTLS certificates, whose trust bundle is mounted in the Ray Pods.
+	MTLSCertificateIssuer *rayv1.CertificateIssuer
+	// DashboardGateway is the default Gateway configuration of the HTTPRoutes of the Ray dashboards.
+	DashboardGateway *configapi.DashboardGatewayConfig
+	// AuthProxy overrides the images, resources, and args of the auth proxy sidecars.
+	AuthProxy *configapi.AuthProxyConfig
 }
 
 // Reconcile reads that state of the cluster for a RayCluster object and makes changes based on it
@@ -108,6 +122,7 @@ type RayClusterReconcilerOptions struct {
 // +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch;create;update;patch;delete;deletecollection
 // +kubebuilder:rbac:groups=core,resources=pods/status,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=get;list;watch;create
 // +kubebuilder:rbac:groups=core,resources=services/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;create;update
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingressclasses,verbs=get;list;watch
@@ -117,6 +132,7 @@ type RayClusterReconcilerOptions struct {
 // +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;delete
 // +kubebuilder:rbac:groups="rbac.authorization.k8s.io",resources=roles,verbs=get;list;watch;create;delete;update
 // +kubebuilder:rbac:groups="rbac.authorization.k8s.io",resources=rolebindings,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
 
 // [WARNING]: There MUST be a newline after kubebuilder markers.
 
@@ -302,6 +318,8 @@ func (r *RayClusterReconciler) rayClusterReconcile(ctx context.Context, instance
 	}
 
 	reconcileFuncs := []reconcileFunc{
+		// The suspendSchedule goes first so that a failure to reconcile the other resources doesn't delay its actions.
+		r.reconcileSuspendSchedule,
 		r.reconcileAutoscalerServiceAccount,
 		r.reconcileAutoscalerRole,
 		r.reconcileAutoscalerRoleBinding,
@@ -309,7 +327,10 @@ func (r *RayClusterReconciler) rayClusterReconcile(ctx context.Context, instance
 		r.reconcileHeadService,
 		r.reconcileHeadlessService,
 		r.reconcileServeService,
+		r.reconcilePodDisruptionBudgets,
 		r.reconcilePods,
+		r.reconcileVolumeClaims,
+		r.reconcileIdleSuspend,
 	}
 
 	for _, fn := range reconcileFuncs {
@@ -346,6 +367,26 @@ func (r *RayClusterReconciler) rayClusterReconcile(ctx context.Context, instance
 		return ctrl.Result{RequeueAfter: DefaultRequeueDuration}, err
 	}
 
+	// Check the draining worker Pods frequently so that they are deleted soon after their Ray nodes exit.
+	if newInstance.Status.DrainingWorkerReplicas > 0 {
+		return ctrl.Result{RequeueAfter: DefaultRequeueDuration}, nil
+	}
+
+	// Check an idle RayCluster again when its idle timeout expires so that it is suspended on time.
+	if newInstance.Status.IdleSince != nil && newInstance.Spec.IdleSuspendPolicy != nil {
+		idleTimeout := time.Duration(newInstance.Spec.IdleSuspendPolicy.IdleTimeoutSeconds) * time.Second
+		if requeueAfter := time.Until(newInstance.Status.IdleSince.Add(idleTimeout)); requeueAfter < time.Duration(utils.RAYCLUSTER_DEFAULT_REQUEUE_SECONDS)*time.Second {
+			return ctrl.Result{RequeueAfter: max(requeueAfter, DefaultRequeueDuration)}, nil
+		}
+	}
+
+	// Check the RayCluster again when its next scheduled action is due so that it is suspended or resumed on time.
+	if next := newInstance.Status.NextScheduledAction; next != nil && newInstance.Spec.SuspendSchedule != nil {
+		if requeueAfter := time.Until(next.Time.Time); requeueAfter < time.Duration(utils.RAYCLUSTER_DEFAULT_REQUEUE_SECONDS)*time.Second {
+			return ctrl.Result{RequeueAfter: max(requeueAfter, DefaultRequeueDuration)}, nil
+		}
+	}
+
 	// Unconditionally requeue after the number of seconds specified in the
 	// environment variable RAYCLUSTER_DEFAULT_REQUEUE_SECONDS_ENV. If the
 	// environment variable is not set, requeue after the default value.
@@ -385,7 +426,9 @@ func (r *RayClusterReconciler) inconsistentRayClusterStatus(ctx context.Context,
 		oldStatus.AvailableWorkerReplicas != newStatus.AvailableWorkerReplicas ||
 		oldStatus.DesiredWorkerReplicas != newStatus.DesiredWorkerReplicas ||
 		oldStatus.MinWorkerReplicas != newStatus.MinWorkerReplicas ||
-		oldStatus.MaxWorkerReplicas != newStatus.MaxWorkerReplicas {
+		oldStatus.MaxWorkerReplicas != newStatus.MaxWorkerReplicas ||
+		oldStatus.UpdatedWorkerReplicas != newStatus.UpdatedWorkerReplicas ||
+		oldStatus.DrainingWorkerReplicas != newStatus.DrainingWorkerReplicas {
 		logger.Info(
 			"inconsistentRayClusterStatus",
 			"oldReadyWorkerReplicas", oldStatus.ReadyWorkerReplicas,
@@ -398,6 +441,18 @@ func (r *RayClusterReconciler) inconsistentRayClusterStatus(ctx context.Context,
 			"newMinWorkerReplicas", newStatus.MinWorkerReplicas,
 			"oldMaxWorkerReplicas", oldStatus.MaxWorkerReplicas,
 			"newMaxWorkerReplicas", newStatus.MaxWorkerReplicas,
+			"oldUpdatedWorkerReplicas", oldStatus.UpdatedWorkerReplicas,
+			"newUpdatedWorkerReplicas", newStatus.UpdatedWorkerReplicas,
+			"oldDrainingWorkerReplicas", oldStatus.DrainingWorkerReplicas,
+			"newDrainingWorkerReplicas", newStatus.DrainingWorkerReplicas,
+		)
+		return true
+	}
+	if !reflect.DeepEqual(oldStatus.WorkerGroupStatuses, newStatus.WorkerGroupStatuses) {
+		logger.Info(
+			"inconsistentRayClusterStatus",
+			"oldWorkerGroupStatuses", oldStatus.WorkerGroupStatuses,
+			"newWorkerGroupStatuses", newStatus.WorkerGroupStatuses,
 		)
 		return true
 	}
@@ -415,6 +470,18 @@ func (r *RayClusterReconciler) inconsistentRayClusterStatus(ctx context.Context,
 		logger.Info("inconsistentRayClusterStatus", "old conditions", oldStatus.Conditions, "new conditions", newStatus.Conditions)
 		return true
 	}
+	if !oldStatus.IdleSince.Equal(newStatus.IdleSince) {
+		logger.Info("inconsistentRayClusterStatus", "oldIdleSince", oldStatus.IdleSince, "newIdleSince", newStatus.IdleSince)
+		return true
+	}
+	if !reflect.DeepEqual(oldStatus.NextScheduledAction, newStatus.NextScheduledAction) {
+		logger.Info("inconsistentRayClusterStatus", "oldNextScheduledAction", oldStatus.NextScheduledAction, "newNextScheduledAction", newStatus.NextScheduledAction)
+		return true
+	}
+	if !reflect.DeepEqual(oldStatus.Security, newStatus.Security) {
+		logger.Info("inconsistentRayClusterStatus", "oldSecurity", oldStatus.Security, "newSecurity", newStatus.Security)
+		return true
+	}
 	return false
 }
 
@@ -577,6 +644,8 @@ func (r *RayClusterReconciler) reconcileServeService(ctx context.Context, instan
 }
 
 // Return nil only when the headless service for multi-host worker groups is successfully created or already exists.
+// The headless service is also created when the mTLS certificates use DNS SANs, since the worker Pods advertise
+// their DNS names under it.
 func (r *RayClusterReconciler) reconcileHeadlessService(ctx context.Context, instance *rayv1.RayCluster) error {
 	// Check if there are worker groups with NumOfHosts > 1 in the cluster
 	isMultiHost := false
@@ -587,7 +656,7 @@ func (r *RayClusterReconciler) reconcileHeadlessService(ctx context.Context, ins
 		}
 	}
 
-	if isMultiHost {
+	if isMultiHost || utils.IsDNSCertificateSANsEnabled(instance) {
 		services := corev1.ServiceList{}
 		options := common.RayClusterHeadlessServiceListOptions(instance)
 
@@ -610,6 +679,405 @@ func (r *RayClusterReconciler) reconcileHeadlessService(ctx context.Context, ins
 	return nil
 }
 
+// reconcilePodDisruptionBudgets creates or updates a PodDisruptionBudget for each group that sets disruptionBudget,
+// and deletes the PodDisruptionBudgets of groups that no longer set it.
+func (r *RayClusterReconciler) reconcilePodDisruptionBudgets(ctx context.Context, instance *rayv1.RayCluster) error {
+	logger := ctrl.LoggerFrom(ctx)
+
+	var desiredPDBs []*policyv1.PodDisruptionBudget
+	if budget := instance.Spec.HeadGroupSpec.DisruptionBudget; budget != nil {
+		pdb, err := common.BuildPodDisruptionBudget(instance, utils.RayNodeHeadGroupLabelValue, *budget, 1, 1)
+		if err != nil {
+			return err
+		}
+		desiredPDBs = append(desiredPDBs, pdb)
+	}
+	for _, worker := range instance.Spec.WorkerGroupSpecs {
+		if worker.DisruptionBudget == nil {
+			continue
+		}
+		numOfHosts := max(worker.NumOfHosts, 1)
+		replicas := utils.GetWorkerGroupDesiredReplicas(ctx, worker) / numOfHosts
+		pdb, err := common.BuildPodDisruptionBudget(instance, worker.GroupName, *worker.DisruptionBudget, replicas, numOfHosts)
+		if err != nil {
+			return err
+		}
+		desiredPDBs = append(desiredPDBs, pdb)
+	}
+	// Skip listing the PodDisruptionBudgets when no group asks for one, unless the spec has changed since it was
+	// last observed, so that the budgets of the groups whose disruptionBudget is removed are still deleted.
+	if len(desiredPDBs) == 0 && instance.Status.ObservedGeneration == instance.Generation {
+		return nil
+	}
+
+	existingPDBs := policyv1.PodDisruptionBudgetList{}
+	if err := r.List(ctx, &existingPDBs, common.RayClusterPodDisruptionBudgetsAssociationOptions(instance).ToListOptions()...); err != nil {
+		return err
+	}
+	existingPDBsByName := make(map[string]*policyv1.PodDisruptionBudget, len(existingPDBs.Items))
+	for i := range existingPDBs.Items {
+		existingPDBsByName[existingPDBs.Items[i].Name] = &existingPDBs.Items[i]
+	}
+
+	for _, desired := range desiredPDBs {
+		existing, ok := existingPDBsByName[desired.Name]
+		if !ok {
+			if err := controllerutil.SetControllerReference(instance, desired, r.Scheme); err != nil {
+				return err
+			}
+			if err := r.Create(ctx, desired); err != nil {
+				r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToCreatePodDisruptionBudget),
+					"Failed creating PodDisruptionBudget %s/%s, %v", desired.Namespace, desired.Name, err)
+				return err
+			}
+			logger.Info("Created PodDisruptionBudget", "name", desired.Name, "minAvailable", desired.Spec.MinAvailable.String())
+			r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.CreatedPodDisruptionBudget),
+				"Created PodDisruptionBudget %s/%s", desired.Namespace, desired.Name)
+			continue
+		}
+		delete(existingPDBsByName, desired.Name)
+
+		if existing.Spec.MaxUnavailable == nil &&
+			reflect.DeepEqual(existing.Spec.MinAvailable, desired.Spec.MinAvailable) &&
+			reflect.DeepEqual(existing.Spec.Selector, desired.Spec.Selector) {
+			continue
+		}
+		existing.Spec.MinAvailable = desired.Spec.MinAvailable
+		existing.Spec.MaxUnavailable = nil
+		existing.Spec.Selector = desired.Spec.Selector
+		if err := r.Update(ctx, existing); err != nil {
+			r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToUpdatePodDisruptionBudget),
+				"Failed updating PodDisruptionBudget %s/%s, %v", existing.Namespace, existing.Name, err)
+			return err
+		}
+		logger.Info("Updated PodDisruptionBudget", "name", existing.Name, "minAvailable", existing.Spec.MinAvailable.String())
+		r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.UpdatedPodDisruptionBudget),
+			"Updated PodDisruptionBudget %s/%s", existing.Namespace, existing.Name)
+	}
+
+	// The remaining PodDisruptionBudgets belong to groups that were removed or no longer set disruptionBudget.
+	for _, stale := range existingPDBsByName {
+		if !metav1.IsControlledBy(stale, instance) {
+			continue
+		}
+		if err := r.Delete(ctx, stale); client.IgnoreNotFound(err) != nil {
+			r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToDeletePodDisruptionBudget),
+				"Failed deleting PodDisruptionBudget %s/%s, %v", stale.Namespace, stale.Name, err)
+			return err
+		}
+		logger.Info("Deleted PodDisruptionBudget", "name", stale.Name)
+		r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.DeletedPodDisruptionBudget),
+			"Deleted PodDisruptionBudget %s/%s", stale.Namespace, stale.Name)
+	}
+
+	return nil
+}
+
+// reconcileVolumeClaims creates the missing PersistentVolumeClaims of the Pods in groups with volumeClaimTemplates,
+// for example, when creating them failed after the Pod had been created.
+func (r *RayClusterReconciler) reconcileVolumeClaims(ctx context.Context, instance *rayv1.RayCluster) error {
+	type groupVolumeClaims struct {
+		policy    *rayv1.VolumeClaimRetentionPolicy
+		templates []corev1.PersistentVolumeClaim
+	}
+	groups := map[string]groupVolumeClaims{}
+	if len(instance.Spec.HeadGroupSpec.VolumeClaimTemplates) > 0 {
+		groups[utils.RayNodeHeadGroupLabelValue] = groupVolumeClaims{
+			templates: instance.Spec.HeadGroupSpec.VolumeClaimTemplates,
+			policy:    instance.Spec.HeadGroupSpec.VolumeClaimRetentionPolicy,
+		}
+	}
+	for _, worker := range instance.Spec.WorkerGroupSpecs {
+		if len(worker.VolumeClaimTemplates) > 0 {
+			groups[worker.GroupName] = groupVolumeClaims{templates: worker.VolumeClaimTemplates, policy: worker.VolumeClaimRetentionPolicy}
+		}
+	}
+	if len(groups) == 0 {
+		return nil
+	}
+
+	pods := corev1.PodList{}
+	if err := r.List(ctx, &pods, common.RayClusterAllPodsAssociationOptions(instance).ToListOptions()...); err != nil {
+		return err
+	}
+	claims := corev1.PersistentVolumeClaimList{}
+	if err := r.List(ctx, &claims, common.RayClusterPersistentVolumeClaimsAssociationOptions(instance).ToListOptions()...); err != nil {
+		return err
+	}
+	existingClaims := make(map[string]bool, len(claims.Items))
+	for _, claim := range claims.Items {
+		existingClaims[claim.Name] = true
+	}
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		group, ok := groups[pod.Labels[utils.RayNodeGroupLabelKey]]
+		if !ok || pod.DeletionTimestamp != nil {
+			continue
+		}
+		// Only the claims that the Pod refers to are created. Pods created before a template was added don't have them.
+		var missing []corev1.PersistentVolumeClaim
+		for _, template := range group.templates {
+			if claimName, ok := common.GetVolumeClaimName(pod, template.Name); ok && !existingClaims[claimName] {
+				missing = append(missing, template)
+			}
+		}
+		if err := r.createVolumeClaims(ctx, instance, pod, missing, group.policy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// drainWorkerPod drains the Ray node of a worker Pod in WorkersToDelete through the Ray dashboard. It returns true
+// once the Pod can be deleted, that is, the Ray node has exited, the drain has timed out, or the node cannot be drained.
+func (r *RayClusterReconciler) drainWorkerPod(ctx context.Context, instance *rayv1.RayCluster, pod corev1.Pod, drainTimeout time.Duration) (bool, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	// There is nothing to drain if the Ray node is not running.
+	if drainTimeout <= 0 || pod.DeletionTimestamp != nil || !utils.IsRunningAndReady(&pod) || pod.Status.PodIP == "" {
+		return true, nil
+	}
+
+	startTime, draining := utils.GetWorkerPodDrainStartTime(pod)
+	if draining && time.Since(startTime) >= drainTimeout {
+		logger.Info("Timed out draining the Ray node of the worker Pod", "Pod", pod.Name, "drainStartTime", startTime)
+		return true, nil
+	}
+
+	dashboardClient, err := r.newRayDashboardClient(ctx, instance)
+	var node *utils.RayNodeInfo
+	if err == nil {
+		node, err = findAliveRayNode(ctx, dashboardClient, pod.Status.PodIP)
+	}
+	if err != nil {
+		if draining {
+			// Check the Ray node again in the next reconciliation. The drain is still bounded by the drain timeout.
+			logger.Info("Failed to check the Ray node of the draining worker Pod", "Pod", pod.Name, "error", err)
+			return false, nil
+		}
+		r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToDrainWorkerPod),
+			"Failed draining worker Pod %s/%s, deleting it without draining: %v", pod.Namespace, pod.Name, err)
+		return true, nil
+	}
+	if node == nil {
+		logger.Info("The Ray node of the worker Pod has exited", "Pod", pod.Name)
+		return true, nil
+	}
+	if draining {
+		return false, nil
+	}
+
+	startTime = time.Now()
+	reasonMessage := fmt.Sprintf("worker Pod %s/%s is being scaled down by KubeRay", pod.Namespace, pod.Name)
+	if err := dashboardClient.DrainNode(ctx, node.NodeID, utils.RayNodeDrainReasonPreemption, reasonMessage, startTime.Add(drainTimeout)); err != nil {
+		r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToDrainWorkerPod),
+			"Failed draining worker Pod %s/%s, deleting it without draining: %v", pod.Namespace, pod.Name, err)
+		return true, nil
+	}
+
+	patch := client.MergeFrom(pod.DeepCopy())
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	pod.Annotations[utils.RayWorkerDrainStartTimeKey] = startTime.UTC().Format(time.RFC3339)
+	if err := r.Patch(ctx, &pod, patch); err != nil {
+		return false, err
+	}
+	r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.DrainingWorkerPod),
+		"Draining worker Pod %s/%s for up to %s before deleting it", pod.Namespace, pod.Name, drainTimeout)
+	return false, nil
+}
+
+// reconcileIdleSuspend suspends the RayCluster once it has been idle for the IdleTimeoutSeconds of its idleSuspendPolicy.
+// The time since which the RayCluster has been idle is kept in the status, and the RayCluster is suspended by setting
+// `suspend` to true, so it goes through the same path as a RayCluster suspended by a user.
+func (r *RayClusterReconciler) reconcileIdleSuspend(ctx context.Context, instance *rayv1.RayCluster) error {
+	logger := ctrl.LoggerFrom(ctx)
+	policy := instance.Spec.IdleSuspendPolicy
+	if policy == nil || (instance.Spec.Suspend != nil && *instance.Spec.Suspend) || instance.Status.State != rayv1.Ready {
+		instance.Status.IdleSince = nil
+		return nil
+	}
+
+	active, err := r.isRayClusterActive(ctx, instance, policy)
+	if err != nil {
+		// The activity is unknown, e.g. while the head Pod restarts, so the RayCluster is considered neither idle nor active.
+		logger.Info("Failed to check whether the RayCluster is idle", "error", err)
+		return nil
+	}
+	if active {
+		instance.Status.IdleSince = nil
+		return nil
+	}
+	now := metav1.Now()
+	if instance.Status.IdleSince == nil {
+		logger.Info("The RayCluster is idle", "idleTimeoutSeconds", policy.IdleTimeoutSeconds)
+		instance.Status.IdleSince = &now
+		return nil
+	}
+	idleSince := instance.Status.IdleSince.DeepCopy()
+	if now.Sub(idleSince.Time) < time.Duration(policy.IdleTimeoutSeconds)*time.Second {
+		return nil
+	}
+
+	logger.Info("Suspending the idle RayCluster", "idleSince", idleSince, "idleTimeoutSeconds", policy.IdleTimeoutSeconds)
+	patch := client.MergeFrom(instance.DeepCopy())
+	instance.Spec.Suspend = ptr.To(true)
+	if err := r.Patch(ctx, instance, patch); err != nil {
+		r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToSuspendIdleRayCluster),
+			"Failed to suspend the RayCluster %s/%s that has been idle since %s, %v", instance.Namespace, instance.Name, idleSince.Format(time.RFC3339), err)
+		return err
+	}
+	r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.SuspendedIdleRayCluster),
+		"Suspended the RayCluster %s/%s that has been idle since %s", instance.Namespace, instance.Name, idleSince.Format(time.RFC3339))
+	instance.Status.IdleSince = nil
+	if features.Enabled(features.RayClusterStatusConditions) {
+		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+			Type:   string(rayv1.RayClusterIdleSuspended),
+			Status: metav1.ConditionTrue,
+			Reason: rayv1.IdleTimeoutExceeded,
+			Message: fmt.Sprintf("The RayCluster has had no running Ray jobs or serve applications since %s, longer than the idle timeout of %d seconds",
+				idleSince.Format(time.RFC3339), policy.IdleTimeoutSeconds),
+		})
+	}
+	return nil
+}
+
+// reconcileSuspendSchedule suspends or resumes the RayCluster when the next action of its suspendSchedule, which
+// is kept in the status, is due, and then records the following action. If more actions have been due since, e.g.
+// because the operator was down, only the latest one is applied. The action is applied by setting `suspend`,
+// so the RayCluster goes through the same suspend and resume path as when a user sets it, and a user can still
+// suspend or resume the RayCluster in between scheduled actions.
+func (r *RayClusterReconciler) reconcileSuspendSchedule(ctx context.Context, instance *rayv1.RayCluster) error {
+	schedule := instance.Spec.SuspendSchedule
+	if schedule == nil {
+		instance.Status.NextScheduledAction = nil
+		return nil
+	}
+
+	now := time.Now()
+	if due := instance.Status.NextScheduledAction; due != nil && !now.Before(due.Time.Time) {
+		latest, err := utils.GetLatestScheduledAction(schedule, due.Time.Time, now)
+		if err != nil {
+			return fmt.Errorf("failed to compute the latest action of the suspendSchedule: %w", err)
+		}
+		if latest != nil {
+			due = latest
+		}
+		if err := r.applyScheduledAction(ctx, instance, due); err != nil {
+			return err
+		}
+	}
+
+	// The next action is computed in every reconciliation so that it follows changes to the schedule.
+	next, err := utils.GetNextScheduledAction(schedule, now)
+	if err != nil {
+		return fmt.Errorf("failed to compute the next action of the suspendSchedule: %w", err)
+	}
+	instance.Status.NextScheduledAction = next
+	return nil
+}
+
+// applyScheduledAction suspends or resumes the RayCluster as scheduled, unless it is already suspended or resumed.
+func (r *RayClusterReconciler) applyScheduledAction(ctx context.Context, instance *rayv1.RayCluster, due *rayv1.ScheduledAction) error {
+	logger := ctrl.LoggerFrom(ctx)
+	suspend := due.Action == rayv1.ScheduledSuspend
+	if (instance.Spec.Suspend != nil && *instance.Spec.Suspend) == suspend {
+		return nil
+	}
+
+	logger.Info("Applying the scheduled action", "action", due.Action, "scheduledTime", due.Time)
+	patch := client.MergeFrom(instance.DeepCopy())
+	instance.Spec.Suspend = ptr.To(suspend)
+	if err := r.Patch(ctx, instance, patch); err != nil {
+		r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToApplyScheduledRayClusterAction),
+			"Failed to %s the RayCluster %s/%s as scheduled at %s, %v", strings.ToLower(string(due.Action)), instance.Namespace, instance.Name, due.Time.Format(time.RFC3339), err)
+		return err
+	}
+	eventType, verb := utils.ScheduledResumeRayCluster, "Resumed"
+	if suspend {
+		eventType, verb = utils.ScheduledSuspendRayCluster, "Suspended"
+	}
+	r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(eventType),
+		"%s the RayCluster %s/%s as scheduled at %s", verb, instance.Namespace, instance.Name, due.Time.Format(time.RFC3339))
+	return nil
+}
+
+// isRayClusterActive returns whether the RayCluster has a Ray job that is pending or running or, unless the
+// idleSuspendPolicy doesn't count them, a Ray Serve application that is deploying or serving requests.
+func (r *RayClusterReconciler) isRayClusterActive(ctx context.Context, instance *rayv1.RayCluster, policy *rayv1.IdleSuspendPolicy) (bool, error) {
+	dashboardClient, err := r.newRayDashboardClient(ctx, instance)
+	if err != nil {
+		return false, err
+	}
+	jobs, err := dashboardClient.ListJobs(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to list the Ray jobs: %w", err)
+	}
+	if jobs != nil {
+		for _, job := range *jobs {
+			if !rayv1.IsJobTerminal(job.JobStatus) {
+				return true, nil
+			}
+		}
+	}
+
+	if policy.CountServeApplications != nil && !*policy.CountServeApplications {
+		return false, nil
+	}
+	applications, err := dashboardClient.GetMultiApplicationStatus(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get the Ray Serve application statuses: %w", err)
+	}
+	for _, application := range applications {
+		switch application.Status {
+		case rayv1.ApplicationStatusEnum.DEPLOYING, rayv1.ApplicationStatusEnum.RUNNING, rayv1.ApplicationStatusEnum.UNHEALTHY:
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// newRayDashboardClient returns a Ray dashboard client connected to the head service of the RayCluster.
+func (r *RayClusterReconciler) newRayDashboardClient(ctx context.Context, instance *rayv1.RayCluster) (utils.RayDashboardClientInterface, error) {
+	if r.dashboardClientFunc == nil {
+		return nil, fmt.Errorf("the Ray dashboard client is not configured")
+	}
+	dashboardURL, err := utils.FetchHeadServiceURL(ctx, r.Client, instance, utils.DashboardPortName)
+	if err != nil {
+		return nil, err
+	}
+	dashboardClient := r.dashboardClientFunc()
+	if err := dashboardClient.InitClient(ctx, dashboardURL, instance); err != nil {
+		return nil, err
+	}
+	return dashboardClient, nil
+}
+
+// findAliveRayNode returns the alive Ray worker node with the given IP, or nil if there is none.
+func findAliveRayNode(ctx context.Context, dashboardClient utils.RayDashboardClientInterface, nodeIP string) (*utils.RayNodeInfo, error) {
+	nodes, err := dashboardClient.ListNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range nodes {
+		if nodes[i].NodeIP == nodeIP && nodes[i].State == utils.RayNodeStateAlive && !nodes[i].IsHeadNode {
+			return &nodes[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func findPodByName(pods []corev1.Pod, name string) (corev1.Pod, bool) {
+	for _, pod := range pods {
+		if pod.Name == name {
+			return pod, true
+		}
+	}
+	return corev1.Pod{}, false
+}
+
 func (r *RayClusterReconciler) reconcilePods(ctx context.Context, instance *rayv1.RayCluster) error {
 	logger := ctrl.LoggerFrom(ctx)
 
@@ -656,12 +1124,15 @@ func (r *RayClusterReconciler) reconcilePods(ctx context.Context, instance *rayv
 	}
 	// check if the batch scheduler integration is enabled
 	// call the scheduler plugin if so
+	// The RayJob that owns the RayCluster is looked up once and passed to the batch scheduler for every Pod created.
+	var rayJob *rayv1.RayJob
 	if r.BatchSchedulerMgr != nil {
-		if scheduler, err := r.BatchSchedulerMgr.GetSchedulerForCluster(); err == nil {
-			if err := scheduler.DoBatchSchedulingOnSubmission(ctx, instance); err != nil {
-				return err
-			}
-		} else {
+		scheduler, _ := r.BatchSchedulerMgr.GetSchedulerForCluster(instance)
+		var err error
+		if rayJob, err = r.getOwnerRayJob(ctx, instance); err != nil {
+			return err
+		}
+		if err := scheduler.DoBatchSchedulingOnSubmission(ctx, instance, rayJob); err != nil {
 			return err
 		}
 	}
@@ -683,6 +1154,32 @@ func (r *RayClusterReconciler) reconcilePods(ctx context.Context, instance *rayv
 			reason = fmt.Sprintf("mTLS is enabled but head Pod %s doesn't have mTLS configuration. Pod needs to be recreated with mTLS volumes and environment variables.", headPod.Name)
 		}
 
+		// Check if the auth proxy sidecar of the head Pod was configured for another authentication mode
+		if !shouldDelete && utils.IsAuthProxyEnabled(instance) {
+			authMode, err := utils.DetectAuthenticationMode(ctx, r, r.options.IsOpenShift)
+			if err != nil {
+				return fmt.Errorf("failed to detect the authentication mode: %w", err)
+			}
+			if podAuthMode, ok := headPod.Annotations[utils.RayAuthenticationModeAnnotationKey]; ok && podAuthMode != string(authMode) {
+				shouldDelete = true
+				reason = fmt.Sprintf("The authentication mode of the cluster changed from %s to %s. Head Pod %s needs to be recreated with the auth proxy sidecar of the new mode.", podAuthMode, authMode, headPod.Name)
+			}
+		}
+
+		// Check if the auth proxy sidecars of the head Pod were built from another authProxy configuration or listeners
+		if !shouldDelete && utils.IsAuthProxyEnabled(instance) {
+			if podHash, ok := headPod.Annotations[utils.RayAuthProxyHashAnnotationKey]; ok {
+				hash, err := r.authProxySidecarsHash(instance)
+				if err != nil {
+					return fmt.Errorf("failed to hash the auth proxy sidecar: %w", err)
+				}
+				if podHash != hash {
+					shouldDelete = true
+					reason = fmt.Sprintf("The auth proxy configuration changed. Head Pod %s needs to be recreated with the new auth proxy sidecars.", headPod.Name)
+				}
+			}
+		}
+
 		logger.Info("reconcilePods", "head Pod", headPod.Name, "shouldDelete", shouldDelete, "reason", reason)
 		if shouldDelete {
 			if err := r.Delete(ctx, &headPod); err != nil {
@@ -700,7 +1197,7 @@ func (r *RayClusterReconciler) reconcilePods(ctx context.Context, instance *rayv
 	} else if len(headPods.Items) == 0 {
 		// Create head Pod if it does not exist.
 		logger.Info("reconcilePods: Found 0 head Pods; creating a head Pod for the RayCluster.")
-		if err := r.createHeadPod(ctx, *instance); err != nil {
+		if err := r.createHeadPod(ctx, *instance, rayJob); err != nil {
 			return errstd.Join(utils.ErrFailedCreateHeadPod, err)
 		}
 	} else if len(headPods.Items) > 1 { // This should never happen. This protects against the case that users manually create headpod.
@@ -742,17 +1239,26 @@ func (r *RayClusterReconciler) reconcilePods(ctx context.Context, instance *rayv
 			continue
 		}
 
-		// Delete unhealthy worker Pods.
+		// The Pods of a multi-host replica are deleted together with the Pods specified in WorkersToDelete.
+		workersToDelete := worker.ScaleStrategy.WorkersToDelete
+		if worker.NumOfHosts > 1 {
+			workersToDelete = expandToMultiHostReplicas(workersToDelete, workerPods.Items)
+		}
+
+		// Delete unhealthy worker Pods. A multi-host replica is recreated as a whole if any of its hosts is unhealthy.
+		var unhealthyReplicas map[int]string
+		if worker.NumOfHosts > 1 {
+			unhealthyReplicas = r.findUnhealthyMultiHostReplicas(instance, worker, workerPods.Items, workersToDelete)
+		}
 		deletedWorkers := make(map[string]struct{})
 		deleted := struct{}{}
 		numDeletedUnhealthyWorkerPods := 0
 		for _, workerPod := range workerPods.Items {
-			shouldDelete, reason := shouldDeletePod(workerPod, rayv1.WorkerNode)
-
-			// Check if mTLS is enabled but pod doesn't have mTLS configuration
-			if !shouldDelete && r.isMTLSEnabled(instance) && !podHasMTLSConfiguration(workerPod) {
-				shouldDelete = true
-				reason = fmt.Sprintf("mTLS is enabled but worker Pod %s doesn't have mTLS configuration. Pod needs to be recreated with mTLS volumes and environment variables.", workerPod.Name)
+			shouldDelete, reason := r.shouldDeleteWorkerPod(instance, workerPod)
+			if index, ok := utils.GetWorkerReplicaIndex(workerPod); ok && !shouldDelete && workerPod.DeletionTimestamp == nil {
+				if replicaReason, ok := unhealthyReplicas[index]; ok {
+					shouldDelete, reason = true, replicaReason
+				}
 			}
 
 			logger.Info("reconcilePods", "worker Pod", workerPod.Name, "shouldDelete", shouldDelete, "reason", reason)
@@ -780,10 +1286,25 @@ func (r *RayClusterReconciler) reconcilePods(ctx context.Context, instance *rayv
 		// Always remove the specified WorkersToDelete - regardless of the value of Replicas.
 		// Essentially WorkersToDelete has to be deleted to meet the expectations of the Autoscaler.
 		logger.Info("reconcilePods", "removing the pods in the scaleStrategy of", worker.GroupName)
-		for _, podsToDelete := range worker.ScaleStrategy.WorkersToDelete {
+		for _, podsToDelete := range workersToDelete {
 			pod := corev1.Pod{}
 			pod.Name = podsToDelete
 			pod.Namespace = utils.GetNamespace(instance.ObjectMeta)
+			// If the worker group has a drain timeout, the Pod is only deleted after its Ray node is drained.
+			// A Pod that is being drained is considered deleted so that it is neither replaced nor scaled down.
+			if worker.DrainTimeoutSeconds != nil {
+				if workerPod, ok := findPodByName(workerPods.Items, podsToDelete); ok {
+					drainTimeout := time.Duration(*worker.DrainTimeoutSeconds) * time.Second
+					canDelete, err := r.drainWorkerPod(ctx, instance, workerPod, drainTimeout)
+					if err != nil {
+						return err
+					}
+					if !canDelete {
+						deletedWorkers[pod.Name] = deleted
+						continue
+					}
+				}
+			}
 			logger.Info("Deleting pod", "namespace", pod.Namespace, "name", pod.Name)
 			if err := r.Delete(ctx, &pod); err != nil {
 				if !errors.IsNotFound(err) {
@@ -812,6 +1333,21 @@ func (r *RayClusterReconciler) reconcilePods(ctx context.Context, instance *rayv
 		if worker.NumOfHosts <= 0 {
 			worker.NumOfHosts = 1
 		}
+
+		// The Pods deleted above still hold their volume claim indices until they are gone.
+		claimIndices, err := r.newVolumeClaimIndices(ctx, instance, worker.GroupName, worker.VolumeClaimTemplates, workerPods.Items)
+		if err != nil {
+			return err
+		}
+
+		// Replace the Pods created from an outdated template if the worker group has an update strategy.
+		// The worker group is not scaled in the same reconciliation while it is being updated.
+		if updating, err := r.reconcileWorkerGroupUpdate(ctx, instance, worker, runningPods.Items, numExpectedWorkerPods, claimIndices, rayJob); err != nil {
+			return err
+		} else if updating {
+			continue
+		}
+
 		diff := numExpectedWorkerPods - len(runningPods.Items)
 
 		logger.Info("reconcilePods", "workerReplicas", numExpectedWorkerPods, "NumOfHosts", worker.NumOfHosts, "runningPods", len(runningPods.Items), "diff", diff)
@@ -819,10 +1355,23 @@ func (r *RayClusterReconciler) reconcilePods(ctx context.Context, instance *rayv
 		if diff > 0 {
 			// pods need to be added
 			logger.Info("reconcilePods", "Number workers to add", diff, "Worker group", worker.GroupName)
+			if worker.NumOfHosts > 1 {
+				// Multi-host replicas are created as a whole, with the lowest replica indices that are not in use.
+				// If the group still has Pods created before replicas were labeled, the remaining Pods are created
+				// one by one as before.
+				replicaIndices := utils.GetFreeReplicaIndices(workerPods.Items, diff/int(worker.NumOfHosts))
+				for _, replicaIndex := range replicaIndices {
+					logger.Info("reconcilePods", "creating multi-host replica for group", worker.GroupName, "replica index", replicaIndex, "NumOfHosts", worker.NumOfHosts)
+					if err := r.createWorkerReplica(ctx, *instance, *worker.DeepCopy(), replicaIndex, claimIndices, rayJob); err != nil {
+						return errstd.Join(utils.ErrFailedCreateWorkerPod, err)
+					}
+				}
+				diff %= int(worker.NumOfHosts)
+			}
 			// create all workers of this group
 			for i := 0; i < diff; i++ {
 				logger.Info("reconcilePods", "creating worker for group", worker.GroupName, "index", i, "total", diff)
-				if err := r.createWorkerPod(ctx, *instance, *worker.DeepCopy()); err != nil {
+				if err := r.createWorkerPod(ctx, *instance, *worker.DeepCopy(), claimIndices, rayJob); err != nil {
 					return errstd.Join(utils.ErrFailedCreateWorkerPod, err)
 				}
 			}
@@ -849,10 +1398,12 @@ func (r *RayClusterReconciler) reconcilePods(ctx context.Context, instance *rayv
 			// is not set, we will disable random Pod deletion by default.
 			if !enableInTreeAutoscaling || enableRandomPodDelete {
 				// diff < 0 means that we need to delete some Pods to meet the desired number of replicas.
-				randomlyRemovedWorkers := -diff
+				// Multi-host replicas are only deleted as a whole.
+				podsToScaleDown := selectWorkerPodsToScaleDown(runningPods.Items, -diff, worker.NumOfHosts)
+				randomlyRemovedWorkers := len(podsToScaleDown)
 				logger.Info("reconcilePods", "Number workers to delete randomly", randomlyRemovedWorkers, "Worker group", worker.GroupName)
 				for i := 0; i < randomlyRemovedWorkers; i++ {
-					randomPodToDelete := runningPods.Items[i]
+					randomPodToDelete := podsToScaleDown[i]
 					logger.Info("Randomly deleting Pod", "progress", fmt.Sprintf("%d / %d", i+1, randomlyRemovedWorkers), "with name", randomPodToDelete.Name)
 					if err := r.Delete(ctx, &randomPodToDelete); err != nil {
 						if !errors.IsNotFound(err) {
@@ -872,6 +1423,225 @@ func (r *RayClusterReconciler) reconcilePods(ctx context.Context, instance *rayv
 	return nil
 }
 
+// reconcileWorkerGroupUpdate replaces worker Pods created from an outdated template according to the UpdateStrategy
+// of the worker group. It returns true if the worker group still has outdated Pods.
+//
+// Recreate deletes all outdated Pods at once, and the new Pods are created by the following reconciliations.
+// RollingUpdate creates at most maxSurge Pods above the desired number of Pods and only deletes ready outdated
+// Pods as long as at least (desired - maxUnavailable) Pods stay ready. Outdated Pods that are not ready can
+// always be deleted because deleting them does not reduce the availability of the worker group.
+// For a multi-host worker group, Pods are created and deleted by whole replicas, so the bounds are rounded up to
+// whole replicas.
+func (r *RayClusterReconciler) reconcileWorkerGroupUpdate(ctx context.Context, instance *rayv1.RayCluster, worker rayv1.WorkerGroupSpec, workerPods []corev1.Pod, numExpectedWorkerPods int, claimIndices *volumeClaimIndices, rayJob *rayv1.RayJob) (bool, error) {
+	logger := ctrl.LoggerFrom(ctx)
+	strategyType := utils.GetWorkerGroupUpdateStrategyType(worker)
+	if strategyType == rayv1.OnDeleteUpdateStrategy {
+		return false, nil
+	}
+
+	templateHash, err := utils.GenerateWorkerGroupTemplateHash(worker)
+	if err != nil {
+		return false, err
+	}
+	if err := r.backfillWorkerPodTemplateHashes(ctx, workerPods, templateHash); err != nil {
+		return false, err
+	}
+	var outdatedPods []corev1.Pod
+	numUpdatedPods, numReadyPods := 0, 0
+	for _, pod := range workerPods {
+		if utils.IsRunningAndReady(&pod) {
+			numReadyPods++
+		}
+		if utils.IsWorkerPodOutdated(pod, templateHash) {
+			outdatedPods = append(outdatedPods, pod)
+		} else {
+			numUpdatedPods++
+		}
+	}
+	if len(outdatedPods) == 0 {
+		return false, nil
+	}
+
+	var podsToDelete []corev1.Pod
+	numPodsToCreate := 0
+	switch strategyType {
+	case rayv1.RecreateUpdateStrategy:
+		podsToDelete = outdatedPods
+	case rayv1.RollingUpdateStrategy:
+		maxSurge, maxUnavailable, err := utils.GetWorkerGroupRollingUpdateBounds(worker, numExpectedWorkerPods)
+		if err != nil {
+			return true, err
+		}
+		numPodsToCreate = max(0, min(numExpectedWorkerPods+maxSurge-len(workerPods), numExpectedWorkerPods-numUpdatedPods))
+		numReadyPodsToDelete := numReadyPods - (numExpectedWorkerPods - maxUnavailable)
+		for _, pod := range outdatedPods {
+			if !utils.IsRunningAndReady(&pod) {
+				podsToDelete = append(podsToDelete, pod)
+			} else if numReadyPodsToDelete > 0 {
+				podsToDelete = append(podsToDelete, pod)
+				numReadyPodsToDelete--
+			}
+		}
+	}
+	if worker.NumOfHosts > 1 {
+		podNames := make([]string, 0, len(podsToDelete))
+		for _, pod := range podsToDelete {
+			podNames = append(podNames, pod.Name)
+		}
+		podsToDelete = podsToDelete[:0]
+		for _, name := range expandToMultiHostReplicas(podNames, workerPods) {
+			if pod, ok := findPodByName(workerPods, name); ok {
+				podsToDelete = append(podsToDelete, pod)
+			}
+		}
+	}
+	logger.Info("reconcileWorkerGroupUpdate", "worker group", worker.GroupName, "strategy", strategyType,
+		"outdated Pods", len(outdatedPods), "updated Pods", numUpdatedPods, "ready Pods", numReadyPods,
+		"Pods to create", numPodsToCreate, "Pods to delete", len(podsToDelete))
+
+	if worker.NumOfHosts > 1 {
+		numReplicasToCreate := (numPodsToCreate + int(worker.NumOfHosts) - 1) / int(worker.NumOfHosts)
+		for _, replicaIndex := range utils.GetFreeReplicaIndices(workerPods, numReplicasToCreate) {
+			if err := r.createWorkerReplica(ctx, *instance, *worker.DeepCopy(), replicaIndex, claimIndices, rayJob); err != nil {
+				return true, errstd.Join(utils.ErrFailedCreateWorkerPod, err)
+			}
+		}
+		numPodsToCreate = 0
+	}
+	for i := 0; i < numPodsToCreate; i++ {
+		if err := r.createWorkerPod(ctx, *instance, *worker.DeepCopy(), claimIndices, rayJob); err != nil {
+			return true, errstd.Join(utils.ErrFailedCreateWorkerPod, err)
+		}
+	}
+	for _, pod := range podsToDelete {
+		if err := r.Delete(ctx, &pod); err != nil {
+			if !errors.IsNotFound(err) {
+				r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToDeleteWorkerPod),
+					"Failed deleting outdated worker Pod %s/%s of worker group %s, %v", pod.Namespace, pod.Name, worker.GroupName, err)
+				return true, errstd.Join(utils.ErrFailedDeleteWorkerPod, err)
+			}
+			logger.Info("reconcileWorkerGroupUpdate", "The worker Pod has already been deleted", pod.Name)
+			continue
+		}
+		r.rayClusterScaleExpectation.ExpectScalePod(pod.Namespace, instance.Name, worker.GroupName, pod.Name, expectations.Delete)
+		r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.DeletedWorkerPod),
+			"Deleted outdated worker Pod %s/%s of worker group %s; update strategy: %s", pod.Namespace, pod.Name, worker.GroupName, strategyType)
+	}
+	return true, nil
+}
+
+// backfillWorkerPodTemplateHashes sets the template hash annotation on the worker Pods created without it, e.g. before the
+// operator supported update strategies. Such Pods are considered up to date, and the annotation lets later template
+// changes be detected.
+func (r *RayClusterReconciler) backfillWorkerPodTemplateHashes(ctx context.Context, workerPods []corev1.Pod, templateHash string) error {
+	for i := range workerPods {
+		pod := &workerPods[i]
+		if _, ok := pod.Annotations[utils.RayWorkerGroupTemplateHashKey]; ok {
+			continue
+		}
+		patch := client.MergeFrom(pod.DeepCopy())
+		if pod.Annotations == nil {
+			pod.Annotations = map[string]string{}
+		}
+		pod.Annotations[utils.RayWorkerGroupTemplateHashKey] = templateHash
+		if err := r.Patch(ctx, pod, patch); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// shouldDeleteWorkerPod returns whether the worker Pod should be deleted and the reason. In addition to the checks of
+// shouldDeletePod, a worker Pod is deleted if mTLS is enabled but the Pod doesn't have the mTLS configuration.
+func (r *RayClusterReconciler) shouldDeleteWorkerPod(instance *rayv1.RayCluster, pod corev1.Pod) (bool, string) {
+	shouldDelete, reason := shouldDeletePod(pod, rayv1.WorkerNode)
+	if !shouldDelete && r.isMTLSEnabled(instance) && !podHasMTLSConfiguration(pod) {
+		shouldDelete = true
+		reason = fmt.Sprintf("mTLS is enabled but worker Pod %s doesn't have mTLS configuration. Pod needs to be recreated with mTLS volumes and environment variables.", pod.Name)
+	}
+	return shouldDelete, reason
+}
+
+// findUnhealthyMultiHostReplicas returns the replicas of a multi-host worker group that must be recreated as a whole,
+// keyed by replica index, with the reason. The Ray workloads on a multi-host replica, e.g. a TPU slice, cannot run on
+// a subset of its hosts, so a replica is recreated if any of its Pods is unhealthy or terminating, or if it is missing
+// Pods. The replicas that are being scaled down through workersToDelete are skipped.
+func (r *RayClusterReconciler) findUnhealthyMultiHostReplicas(instance *rayv1.RayCluster, worker rayv1.WorkerGroupSpec, pods []corev1.Pod, workersToDelete []string) map[int]string {
+	replicas, _ := utils.GroupPodsByReplicaIndex(pods)
+	unhealthyReplicas := make(map[int]string)
+	for index, replicaPods := range replicas {
+		if slices.ContainsFunc(replicaPods, func(pod corev1.Pod) bool { return slices.Contains(workersToDelete, pod.Name) }) {
+			continue
+		}
+		if len(replicaPods) < int(worker.NumOfHosts) {
+			unhealthyReplicas[index] = fmt.Sprintf(
+				"The replica %d of the multi-host worker group %s has %d of its %d hosts. KubeRay will recreate the whole replica.",
+				index, worker.GroupName, len(replicaPods), worker.NumOfHosts)
+			continue
+		}
+		for _, pod := range replicaPods {
+			if pod.DeletionTimestamp != nil {
+				unhealthyReplicas[index] = fmt.Sprintf(
+					"The host Pod %s of the replica %d of the multi-host worker group %s is being deleted. KubeRay will recreate the whole replica.",
+					pod.Name, index, worker.GroupName)
+				break
+			}
+			if shouldDelete, reason := r.shouldDeleteWorkerPod(instance, pod); shouldDelete {
+				unhealthyReplicas[index] = fmt.Sprintf(
+					"The host Pod %s of the replica %d of the multi-host worker group %s is unhealthy. KubeRay will recreate the whole replica. %s",
+					pod.Name, index, worker.GroupName, reason)
+				break
+			}
+		}
+	}
+	return unhealthyReplicas
+}
+
+// expandToMultiHostReplicas returns the names of the Pods together with the names of the other Pods of their multi-host replicas.
+func expandToMultiHostReplicas(podNames []string, pods []corev1.Pod) []string {
+	replicaIndices := make(map[int]bool)
+	for _, name := range podNames {
+		if pod, ok := findPodByName(pods, name); ok {
+			if index, ok := utils.GetWorkerReplicaIndex(pod); ok {
+				replicaIndices[index] = true
+			}
+		}
+	}
+	expanded := slices.Clone(podNames)
+	for _, pod := range pods {
+		if index, ok := utils.GetWorkerReplicaIndex(pod); ok && replicaIndices[index] && !slices.Contains(expanded, pod.Name) {
+			expanded = append(expanded, pod.Name)
+		}
+	}
+	return expanded
+}
+
+// selectWorkerPodsToScaleDown selects at most numPods Pods to delete to scale down a worker group. For a multi-host group,
+// whole replicas are selected, starting from the highest replica index, so that no replica is left with missing hosts.
+// Pods without a replica index, which were created before replicas were labeled, are selected one by one afterwards.
+func selectWorkerPodsToScaleDown(pods []corev1.Pod, numPods int, numOfHosts int32) []corev1.Pod {
+	if numOfHosts <= 1 {
+		return pods[:min(numPods, len(pods))]
+	}
+	replicas, unindexed := utils.GroupPodsByReplicaIndex(pods)
+	indices := slices.Sorted(maps.Keys(replicas))
+	slices.Reverse(indices)
+
+	var selected []corev1.Pod
+	for _, index := range indices {
+		if len(selected)+len(replicas[index]) <= numPods {
+			selected = append(selected, replicas[index]...)
+		}
+	}
+	for _, pod := range unindexed {
+		if len(selected) >= numPods {
+			break
+		}
+		selected = append(selected, pod)
+	}
+	return selected
+}
+
 // shouldDeletePod returns whether the Pod should be deleted and the reason
 //
 // @param pod: The Pod to be checked.
@@ -1034,13 +1804,16 @@ func (r *RayClusterReconciler) createService(ctx context.Context, svc *corev1.Se
 	return nil
 }
 
-func (r *RayClusterReconciler) createHeadPod(ctx context.Context, instance rayv1.RayCluster) error {
+func (r *RayClusterReconciler) createHeadPod(ctx context.Context, instance rayv1.RayCluster, rayJob *rayv1.RayJob) error {
 	logger := ctrl.LoggerFrom(ctx)
 
 	// Check if authentication is enabled and if the required ServiceAccount exists
 	// This prevents a race condition where the pod is created before the AuthenticationController
 	// has had a chance to create the ServiceAccount
-	authMode := utils.DetectAuthenticationMode(r.options.IsOpenShift)
+	authMode, err := utils.DetectAuthenticationMode(ctx, r, r.options.IsOpenShift)
+	if err != nil {
+		return fmt.Errorf("failed to detect the authentication mode: %w", err)
+	}
 	shouldEnableAuth := utils.ShouldEnableOAuth(&instance, authMode) || utils.ShouldEnableOIDC(&instance, authMode)
 	if shouldEnableAuth {
 		namer := utils.NewResourceNamer(&instance)
@@ -1069,12 +1842,19 @@ func (r *RayClusterReconciler) createHeadPod(ctx context.Context, instance rayv1
 	pod := r.buildHeadPod(ctx, instance)
 	// check if the batch scheduler integration is enabled
 	// call the scheduler plugin if so
+	var scheduler schedulerinterface.BatchScheduler
+	schedulerEnabled := true
 	if r.BatchSchedulerMgr != nil {
-		if scheduler, err := r.BatchSchedulerMgr.GetSchedulerForCluster(); err == nil {
-			scheduler.AddMetadataToPod(ctx, &instance, utils.RayNodeHeadGroupLabelValue, &pod)
-		} else {
-			return err
-		}
+		scheduler, schedulerEnabled = r.BatchSchedulerMgr.GetSchedulerForCluster(&instance)
+		scheduler.AddMetadataToPod(ctx, &instance, rayJob, utils.RayNodeHeadGroupLabelValue, &pod)
+	}
+	// There is no head Pod, but the claims of a deleted head Pod may still be being deleted.
+	claimIndices, err := r.newVolumeClaimIndices(ctx, &instance, utils.RayNodeHeadGroupLabelValue, instance.Spec.HeadGroupSpec.VolumeClaimTemplates, nil)
+	if err != nil {
+		return err
+	}
+	if claimIndices != nil {
+		common.AddVolumeClaimTemplateVolumes(&pod, instance.Name, instance.Spec.HeadGroupSpec.VolumeClaimTemplates, claimIndices.next())
 	}
 
 	if err := r.Create(ctx, &pod); err != nil {
@@ -1084,20 +1864,54 @@ func (r *RayClusterReconciler) createHeadPod(ctx context.Context, instance rayv1
 	r.rayClusterScaleExpectation.ExpectScalePod(pod.Namespace, instance.Name, expectations.HeadGroup, pod.Name, expectations.Create)
 	logger.Info("Created head Pod for RayCluster", "name", pod.Name)
 	r.Recorder.Eventf(&instance, corev1.EventTypeNormal, string(utils.CreatedHeadPod), "Created head Pod %s/%s", pod.Namespace, pod.Name)
+	if !schedulerEnabled {
+		r.recordBatchSchedulerNotEnabled(&instance, &pod, scheduler)
+	}
+	return r.createVolumeClaims(ctx, &instance, &pod, instance.Spec.HeadGroupSpec.VolumeClaimTemplates, instance.Spec.HeadGroupSpec.VolumeClaimRetentionPolicy)
+}
+
+func (r *RayClusterReconciler) createWorkerPod(ctx context.Context, instance rayv1.RayCluster, worker rayv1.WorkerGroupSpec, claimIndices *volumeClaimIndices, rayJob *rayv1.RayJob) error {
+	return r.createWorkerPodWithLabels(ctx, instance, worker, nil, claimIndices, rayJob)
+}
+
+// createWorkerReplica creates the Pods of a replica of a worker group. The Pods of a multi-host replica are labeled
+// with the replica index and their host index so that the replica can be recreated and scaled down as a whole.
+func (r *RayClusterReconciler) createWorkerReplica(ctx context.Context, instance rayv1.RayCluster, worker rayv1.WorkerGroupSpec, replicaIndex int, claimIndices *volumeClaimIndices, rayJob *rayv1.RayJob) error {
+	if worker.NumOfHosts <= 1 {
+		return r.createWorkerPod(ctx, instance, worker, claimIndices, rayJob)
+	}
+	for hostIndex := 0; hostIndex < int(worker.NumOfHosts); hostIndex++ {
+		labels := map[string]string{
+			utils.RayWorkerReplicaIndexKey: strconv.Itoa(replicaIndex),
+			utils.RayHostIndexKey:          strconv.Itoa(hostIndex),
+		}
+		if err := r.createWorkerPodWithLabels(ctx, instance, worker, labels, claimIndices, rayJob); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-func (r *RayClusterReconciler) createWorkerPod(ctx context.Context, instance rayv1.RayCluster, worker rayv1.WorkerGroupSpec) error {
+func (r *RayClusterReconciler) createWorkerPodWithLabels(ctx context.Context, instance rayv1.RayCluster, worker rayv1.WorkerGroupSpec, labels map[string]string, claimIndices *volumeClaimIndices, rayJob *rayv1.RayJob) error {
 	logger := ctrl.LoggerFrom(ctx)
 	// build the pod then create it
 	pod := r.buildWorkerPod(ctx, instance, worker)
-	if r.BatchSchedulerMgr != nil {
-		if scheduler, err := r.BatchSchedulerMgr.GetSchedulerForCluster(); err == nil {
-			scheduler.AddMetadataToPod(ctx, &instance, worker.GroupName, &pod)
-		} else {
-			return err
+	if len(labels) > 0 {
+		if pod.Labels == nil {
+			pod.Labels = make(map[string]string, len(labels))
 		}
+		maps.Copy(pod.Labels, labels)
+	}
+	var scheduler schedulerinterface.BatchScheduler
+	schedulerEnabled := true
+	if r.BatchSchedulerMgr != nil {
+		scheduler, schedulerEnabled = r.BatchSchedulerMgr.GetSchedulerForCluster(&instance)
+		scheduler.AddMetadataToPod(ctx, &instance, rayJob, worker.GroupName, &pod)
 	}
+	if claimIndices != nil {
+		common.AddVolumeClaimTemplateVolumes(&pod, instance.Name, worker.VolumeClaimTemplates, claimIndices.next())
+	}
+	common.SetHostnameForSubdomain(&pod)
 
 	replica := pod
 	if err := r.Create(ctx, &replica); err != nil {
@@ -1107,9 +1921,164 @@ func (r *RayClusterReconciler) createWorkerPod(ctx context.Context, instance ray
 	r.rayClusterScaleExpectation.ExpectScalePod(replica.Namespace, instance.Name, worker.GroupName, replica.Name, expectations.Create)
 	logger.Info("Created worker Pod for RayCluster", "name", replica.Name)
 	r.Recorder.Eventf(&instance, corev1.EventTypeNormal, string(utils.CreatedWorkerPod), "Created worker Pod %s/%s", replica.Namespace, replica.Name)
+	if !schedulerEnabled {
+		r.recordBatchSchedulerNotEnabled(&instance, &replica, scheduler)
+	}
+	return r.createVolumeClaims(ctx, &instance, &replica, worker.VolumeClaimTemplates, worker.VolumeClaimRetentionPolicy)
+}
+
+// recordBatchSchedulerNotEnabled reports that a Pod was created with the default scheduler because the scheduler
+// selected by the ray.io/scheduler-name label of the RayCluster isn't enabled in the operator. It is only called
+// when a Pod is created, so that a RayCluster in a steady state doesn't emit the event on every reconciliation.
+func (r *RayClusterReconciler) recordBatchSchedulerNotEnabled(instance *rayv1.RayCluster, pod *corev1.Pod, scheduler schedulerinterface.BatchScheduler) {
+	r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.BatchSchedulerNotEnabled),
+		"Created Pod %s/%s with the default scheduler %s, the scheduler %s selected by the %s label isn't enabled in the operator",
+		pod.Namespace, pod.Name, scheduler.Name(), instance.Labels[utils.RaySchedulerName], utils.RaySchedulerName)
+}
+
+// createVolumeClaims creates the PersistentVolumeClaims of a Pod from the volumeClaimTemplates of its group. The claims
+// are created after the Pod so that they can be owned by it, and the Pod stays pending until they exist. Depending on
+// the retention policy, a claim is owned by the Pod, by the RayCluster, or by nothing.
+func (r *RayClusterReconciler) createVolumeClaims(ctx context.Context, instance *rayv1.RayCluster, pod *corev1.Pod, templates []corev1.PersistentVolumeClaim, policy *rayv1.VolumeClaimRetentionPolicy) error {
+	logger := ctrl.LoggerFrom(ctx)
+	whenPodDeleted, whenClusterDeleted := utils.GetVolumeClaimRetentionPolicy(policy)
+	for _, template := range templates {
+		claimName, ok := common.GetVolumeClaimName(pod, template.Name)
+		if !ok {
+			continue
+		}
+		pvc := common.BuildVolumeClaim(instance, template, pod, claimName)
+		switch {
+		case whenPodDeleted == rayv1.DeleteVolumeClaimRetentionPolicy:
+			// The Pod is owned by the RayCluster, so the claim is deleted together with the RayCluster as well.
+			if err := controllerutil.SetOwnerReference(pod, pvc, r.Scheme); err != nil {
+				return err
+			}
+		case whenClusterDeleted == rayv1.DeleteVolumeClaimRetentionPolicy:
+			if err := controllerutil.SetControllerReference(instance, pvc, r.Scheme); err != nil {
+				return err
+			}
+		}
+		if err := r.Create(ctx, pvc); err != nil {
+			// A claim retained from a deleted Pod with the same volume claim index is mounted by the new Pod.
+			if errors.IsAlreadyExists(err) {
+				continue
+			}
+			r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToCreatePersistentVolumeClaim),
+				"Failed to create PersistentVolumeClaim %s/%s for Pod %s, %v", pvc.Namespace, pvc.Name, pod.Name, err)
+			return err
+		}
+		logger.Info("Created PersistentVolumeClaim", "name", pvc.Name, "pod", pod.Name)
+		r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.CreatedPersistentVolumeClaim),
+			"Created PersistentVolumeClaim %s/%s for Pod %s", pvc.Namespace, pvc.Name, pod.Name)
+	}
 	return nil
 }
 
+// getOwnerRayJob returns the RayJob that controls the RayCluster, or nil if the RayCluster isn't created by a RayJob.
+// Batch schedulers use it to account for the submitter pod of the RayJob.
+func (r *RayClusterReconciler) getOwnerRayJob(ctx context.Context, instance *rayv1.RayCluster) (*rayv1.RayJob, error) {
+	owner := metav1.GetControllerOf(instance)
+	if owner == nil || owner.Kind != string(utils.RayJobCRD) {
+		return nil, nil
+	}
+	rayJob := &rayv1.RayJob{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: instance.Namespace, Name: owner.Name}, rayJob); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return rayJob, nil
+}
+
+// volumeClaimIndices hands out the lowest volume claim indices of a group that are not in use, so that the Pods created
+// in the same reconciliation get distinct indices.
+type volumeClaimIndices struct {
+	used map[int]bool
+}
+
+func (c *volumeClaimIndices) next() int {
+	index := 0
+	for c.used[index] {
+		index++
+	}
+	c.used[index] = true
+	return index
+}
+
+// newVolumeClaimIndices returns the volume claim indices of a group, or nil if the group has no volumeClaimTemplates.
+// An index is in use if one of the Pods of the group has it, including the Pods that are being deleted, or if one of
+// its claims is still owned by a deleted Pod or is being deleted, so a new Pod never mounts a claim that goes away.
+func (r *RayClusterReconciler) newVolumeClaimIndices(ctx context.Context, instance *rayv1.RayCluster, groupName string, templates []corev1.PersistentVolumeClaim, pods []corev1.Pod) (*volumeClaimIndices, error) {
+	if len(templates) == 0 {
+		return nil, nil
+	}
+	claimIndices := &volumeClaimIndices{used: map[int]bool{}}
+	for _, pod := range pods {
+		if index, err := strconv.Atoi(pod.Labels[utils.RayVolumeClaimIndexKey]); err == nil {
+			claimIndices.used[index] = true
+		}
+	}
+
+	claims := corev1.PersistentVolumeClaimList{}
+	if err := r.List(ctx, &claims, common.RayClusterPersistentVolumeClaimsAssociationOptions(instance).ToListOptions()...); err != nil {
+		return nil, err
+	}
+	for _, claim := range claims.Items {
+		if claim.Labels[utils.RayNodeGroupLabelKey] != groupName {
+			continue
+		}
+		index, err := strconv.Atoi(claim.Labels[utils.RayVolumeClaimIndexKey])
+		if err != nil {
+			continue
+		}
+		if claim.DeletionTimestamp != nil || slices.ContainsFunc(claim.OwnerReferences, func(owner metav1.OwnerReference) bool {
+			return owner.Kind == "Pod"
+		}) {
+			claimIndices.used[index] = true
+		}
+	}
+	return claimIndices, nil
+}
+
+// authProxySidecar returns the kube-rbac-proxy sidecar of the head Pod of the RayCluster, built from the authProxy
+// configuration of the operator.
+func (r *RayClusterReconciler) authProxySidecar(cluster *rayv1.RayCluster) corev1.Container {
+	return GetOIDCProxySidecar(cluster, r.options.AuthProxy)
+}
+
+// authProxyListenerSidecars returns the kube-rbac-proxy sidecars in front of the Ray endpoints other than the
+// dashboard that the RayCluster puts behind the auth proxy.
+func (r *RayClusterReconciler) authProxyListenerSidecars(cluster *rayv1.RayCluster) []corev1.Container {
+	var sidecars []corev1.Container
+	if utils.IsServeAuthProxyEnabled(cluster) {
+		sidecars = append(sidecars, GetServeProxySidecar(cluster, r.options.AuthProxy))
+	}
+	if utils.IsClientAuthProxyEnabled(cluster) {
+		sidecars = append(sidecars, GetClientProxySidecar(cluster, r.options.AuthProxy))
+	}
+	return sidecars
+}
+
+// authProxySidecarsHash returns the hash of the inputs of the auth proxy sidecars of the head Pod of the RayCluster:
+// the authProxy configuration of the operator, the listeners of the RayCluster, and the kube-rbac-proxy config, which
+// kube-rbac-proxy only reads when it starts. The sidecars themselves are not hashed, so that upgrading the operator
+// doesn't recreate every head Pod.
+func (r *RayClusterReconciler) authProxySidecarsHash(cluster *rayv1.RayCluster) (string, error) {
+	return utils.GenerateJsonHash(struct {
+		AuthProxy       *configapi.AuthProxyConfig
+		ServeAuthProxy  bool
+		ClientAuthProxy bool
+		ProxyConfig     string
+	}{
+		AuthProxy:       r.options.AuthProxy,
+		ServeAuthProxy:  utils.IsServeAuthProxyEnabled(cluster),
+		ClientAuthProxy: utils.IsClientAuthProxyEnabled(cluster),
+		ProxyConfig:     kubeRBACProxyConfig(cluster),
+	})
+}
+
 // Build head instance pod(s).
 func (r *RayClusterReconciler) buildHeadPod(ctx context.Context, instance rayv1.RayCluster) corev1.Pod {
 	logger := ctrl.LoggerFrom(ctx)
@@ -1131,7 +2100,12 @@ func (r *RayClusterReconciler) buildHeadPod(ctx context.Context, instance rayv1.
 	}
 
 	// Detect authentication mode and inject appropriate sidecar
-	authMode := utils.DetectAuthenticationMode(r.options.IsOpenShift)
+	authMode, err := utils.DetectAuthenticationMode(ctx, r, r.options.IsOpenShift)
+	if err != nil {
+		// createHeadPod has already detected the authentication mode, so this is unlikely to fail
+		logger.Error(err, "Failed to detect authentication mode, defaulting to IntegratedOAuth")
+		authMode = utils.ModeIntegratedOAuth
+	}
 	logger.Info("Detected authentication mode for pod creation", "mode", authMode, "cluster", instance.Name)
 
 	namer := utils.NewResourceNamer(&instance)
@@ -1145,12 +2119,23 @@ func (r *RayClusterReconciler) buildHeadPod(ctx context.Context, instance rayv1.
 			&podConf.Spec,
 			&instance,
 			authMode,
-			GetOIDCProxySidecar,
+			r.authProxySidecar,
 			GetOIDCProxyVolumes,
 			namer.ServiceAccountName(authMode),
 		)
 
 		if result.Injected {
+			if podConf.Annotations == nil {
+				podConf.Annotations = map[string]string{}
+			}
+			podConf.Annotations[utils.RayAuthenticationModeAnnotationKey] = string(authMode)
+			// Put the Serve HTTP endpoint and the Ray client port behind their own listeners if requested
+			podConf.Spec.Containers = append(podConf.Spec.Containers, r.authProxyListenerSidecars(&instance)...)
+			if hash, err := r.authProxySidecarsHash(&instance); err != nil {
+				logger.Error(err, "Failed to hash the auth proxy sidecars")
+			} else {
+				podConf.Annotations[utils.RayAuthProxyHashAnnotationKey] = hash
+			}
 			logger.Info("Authentication sidecar injected successfully",
 				"cluster", instance.Name,
 				"authType", result.AuthType,
@@ -1178,6 +2163,11 @@ func getCreatorCRDType(instance rayv1.RayCluster) utils.CRDType {
 func (r *RayClusterReconciler) buildWorkerPod(ctx context.Context, instance rayv1.RayCluster, worker rayv1.WorkerGroupSpec) corev1.Pod {
 	logger := ctrl.LoggerFrom(ctx)
 	podName := utils.PodName(fmt.Sprintf("%s-%s", instance.Name, worker.GroupName), rayv1.WorkerNode, true)
+	// The hash must be generated before building the Pod template, which may modify the worker group template in place.
+	templateHash, err := utils.GenerateWorkerGroupTemplateHash(worker)
+	if err != nil {
+		logger.Error(err, "Failed to generate the worker group template hash", "worker group", worker.GroupName)
+	}
 	fqdnRayIP := utils.GenerateFQDNServiceName(ctx, instance, instance.Namespace) // Fully Qualified Domain Name
 
 	// The Ray head port used by workers to connect to the cluster (GCS server port for Ray >= 1.11.0, Redis port for older Ray.)
@@ -1188,14 +2178,25 @@ func (r *RayClusterReconciler) buildWorkerPod(ctx context.Context, instance rayv
 		podTemplateSpec.Spec.Containers = append(podTemplateSpec.Spec.Containers, r.options.WorkerSidecarContainers...)
 	}
 
+	rayStartParams := worker.RayStartParams
 	// Configure mTLS if enabled
 	if r.isMTLSEnabled(&instance) {
 		logger.Info("mTLS is enabled, configuring mTLS for worker pod")
 		r.configureMTLSForPod(&podTemplateSpec, instance)
+		if utils.IsDNSCertificateSANsEnabled(&instance) {
+			rayStartParams = workerRayStartParamsWithPodFQDN(instance, worker.RayStartParams)
+		}
 	}
 
 	creatorCRDType := getCreatorCRDType(instance)
-	pod := common.BuildPod(ctx, podTemplateSpec, rayv1.WorkerNode, worker.RayStartParams, headPort, autoscalingEnabled, creatorCRDType, fqdnRayIP)
+	pod := common.BuildPod(ctx, podTemplateSpec, rayv1.WorkerNode, rayStartParams, headPort, autoscalingEnabled, creatorCRDType, fqdnRayIP)
+	// Record the template the Pod is created from so that the worker group update strategy can find outdated Pods.
+	if templateHash != "" {
+		if pod.Annotations == nil {
+			pod.Annotations = map[string]string{}
+		}
+		pod.Annotations[utils.RayWorkerGroupTemplateHashKey] = templateHash
+	}
 	// Set raycluster instance as the owner and controller
 	if err := controllerutil.SetControllerReference(&instance, &pod, r.Scheme); err != nil {
 		logger.Error(err, "Failed to set controller reference for raycluster pod")
@@ -1204,16 +2205,9 @@ func (r *RayClusterReconciler) buildWorkerPod(ctx context.Context, instance rayv
 	return pod
 }
 
-// isMTLSEnabled checks if mTLS is enabled for this RayCluster via annotation
+// isMTLSEnabled checks if mTLS is enabled for this RayCluster via its security options or annotation
 func (r *RayClusterReconciler) isMTLSEnabled(instance *rayv1.RayCluster) bool {
-	// Check if the cluster explicitly enables or disables mTLS via annotation
-	if instance.Annotations != nil {
-		if value, exists := instance.Annotations[utils.EnableSecureTrustedNetworkAnnotationKey]; exists {
-			return value == "true"
-		}
-	}
-	// Default to disabled if no annotation is set
-	return false
+	return utils.IsMTLSEnabled(instance)
 }
 
 // configureMTLSForPod configures mTLS settings for a pod template if mTLS is enabled
@@ -1245,8 +2239,44 @@ func (r *RayClusterReconciler) configureMTLSForPod(podTemplate *corev1.PodTempla
 		r.addCertVolumeMounts(&podTemplate.Spec.InitContainers[i])
 	}
 
-	// Add CA volumes with proper secret references
-	r.addCAVolumes(&podTemplate.Spec, secretName)
+	// Add CA volumes with proper secret references, and the trust bundle of the certificate issuer if it has one
+	var trustBundle *corev1.ConfigMapKeySelector
+	if issuer := utils.GetCertificateIssuer(&instance, r.options.MTLSCertificateIssuer); issuer != nil {
+		trustBundle = issuer.TrustBundle
+	}
+	r.addCAVolumes(&podTemplate.Spec, secretName, trustBundle)
+
+	// With DNS SANs, worker Pods get a DNS record under the headless worker service once their hostname is set at
+	// creation, and the worker certificate covers those records with a wildcard. The head Pod already advertises
+	// the FQDN of the head service.
+	if isWorker && utils.IsDNSCertificateSANsEnabled(&instance) {
+		podTemplate.Spec.Subdomain = utils.GenerateHeadlessServiceName(instance.Name)
+		for i := range podTemplate.Spec.Containers {
+			podTemplate.Spec.Containers[i].Env = append(podTemplate.Spec.Containers[i].Env, corev1.EnvVar{
+				Name: "MY_POD_NAME",
+				ValueFrom: &corev1.EnvVarSource{
+					FieldRef: &corev1.ObjectFieldSelector{
+						FieldPath: "metadata.name",
+					},
+				},
+			})
+		}
+	}
+}
+
+// workerRayStartParamsWithPodFQDN returns a copy of the rayStartParams of a worker group that makes the worker Pod
+// advertise its DNS name under the headless worker service instead of its IP. The Pod name is substituted by
+// Kubernetes from the MY_POD_NAME environment variable. A node-ip-address set by the user is kept.
+func workerRayStartParamsWithPodFQDN(instance rayv1.RayCluster, rayStartParams map[string]string) map[string]string {
+	params := maps.Clone(rayStartParams)
+	if params == nil {
+		params = map[string]string{}
+	}
+	if _, ok := params["node-ip-address"]; !ok {
+		params["node-ip-address"] = fmt.Sprintf("$(MY_POD_NAME).%s.%s.svc.%s",
+			utils.GenerateHeadlessServiceName(instance.Name), instance.Namespace, utils.GetClusterDomainName())
+	}
+	return params
 }
 
 // addTLSEnvironmentVariables adds Ray TLS environment variables to a container
@@ -1306,6 +2336,22 @@ func (r *RayClusterReconciler) checkMTLSSecretsReady(ctx context.Context, instan
 		return fmt.Errorf("failed to get worker certificate secret: %w", err)
 	}
 
+	// With a trust bundle, the CA certificates come from the trust bundle instead of the secrets
+	requireCA := true
+	if issuer := utils.GetCertificateIssuer(instance, r.options.MTLSCertificateIssuer); issuer != nil && issuer.TrustBundle != nil {
+		requireCA = false
+		trustBundle := &corev1.ConfigMap{}
+		if err := r.Get(ctx, client.ObjectKey{Name: issuer.TrustBundle.Name, Namespace: instance.Namespace}, trustBundle); err != nil {
+			if errors.IsNotFound(err) {
+				return fmt.Errorf("trust bundle configmap %s not found", issuer.TrustBundle.Name)
+			}
+			return fmt.Errorf("failed to get trust bundle configmap: %w", err)
+		}
+		if _, ok := trustBundle.Data[issuer.TrustBundle.Key]; !ok {
+			return fmt.Errorf("trust bundle configmap %s missing %s", issuer.TrustBundle.Name, issuer.TrustBundle.Key)
+		}
+	}
+
 	// Verify the secrets have the required keys
 	if _, ok := headSecret.Data["tls.crt"]; !ok {
 		return fmt.Errorf("head secret missing tls.crt")
@@ -1313,7 +2359,7 @@ func (r *RayClusterReconciler) checkMTLSSecretsReady(ctx context.Context, instan
 	if _, ok := headSecret.Data["tls.key"]; !ok {
 		return fmt.Errorf("head secret missing tls.key")
 	}
-	if _, ok := headSecret.Data["ca.crt"]; !ok {
+	if _, ok := headSecret.Data["ca.crt"]; !ok && requireCA {
 		return fmt.Errorf("head secret missing ca.crt")
 	}
 
@@ -1323,7 +2369,7 @@ func (r *RayClusterReconciler) checkMTLSSecretsReady(ctx context.Context, instan
 	if _, ok := workerSecret.Data["tls.key"]; !ok {
 		return fmt.Errorf("worker secret missing tls.key")
 	}
-	if _, ok := workerSecret.Data["ca.crt"]; !ok {
+	if _, ok := workerSecret.Data["ca.crt"]; !ok && requireCA {
 		return fmt.Errorf("worker secret missing ca.crt")
 	}
 
@@ -1333,7 +2379,36 @@ func (r *RayClusterReconciler) checkMTLSSecretsReady(ctx context.Context, instan
 // addCAVolumes adds certificate volumes to a pod spec
 // The secret contains the TLS certificate, private key, and CA certificate
 // cert-manager automatically includes ca.crt in the certificate secret
-func (r *RayClusterReconciler) addCAVolumes(podSpec *corev1.PodSpec, secretName string) {
+// If a trust bundle is given, ca.crt is taken from the trust bundle instead of the secret
+func (r *RayClusterReconciler) addCAVolumes(podSpec *corev1.PodSpec, secretName string, trustBundle *corev1.ConfigMapKeySelector) {
+	if trustBundle != nil {
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name: "ray-tls-vol",
+			VolumeSource: corev1.VolumeSource{
+				Projected: &corev1.ProjectedVolumeSource{
+					Sources: []corev1.VolumeProjection{
+						{
+							Secret: &corev1.SecretProjection{
+								LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+								Items: []corev1.KeyToPath{
+									{Key: "tls.crt", Path: "tls.crt"},
+									{Key: "tls.key", Path: "tls.key"},
+								},
+							},
+						},
+						{
+							ConfigMap: &corev1.ConfigMapProjection{
+								LocalObjectReference: trustBundle.LocalObjectReference,
+								Items:                []corev1.KeyToPath{{Key: trustBundle.Key, Path: "ca.crt"}},
+							},
+						},
+					},
+				},
+			},
+		})
+		return
+	}
+
 	tlsVolumes := []corev1.Volume{
 		{
 			Name: "ray-tls-vol",
@@ -1455,7 +2530,12 @@ func (r *RayClusterReconciler) SetupWithManager(mgr ctrl.Manager, reconcileConcu
 			predicate.AnnotationChangedPredicate{},
 		))).
 		Owns(&corev1.Pod{}).
-		Owns(&corev1.Service{})
+		Owns(&corev1.Service{}).
+		Owns(&policyv1.PodDisruptionBudget{})
+	if r.options.IsOpenShift {
+		// Recreate the head Pods with an auth proxy when the authentication mode of the cluster changes
+		b = b.Watches(&configv1.Authentication{}, handler.EnqueueRequestsFromMapFunc(mapAuthResourceToRayClusters(r)))
+	}
 	if r.BatchSchedulerMgr != nil {
 		r.BatchSchedulerMgr.ConfigureReconciler(b)
 	}
@@ -1514,6 +2594,9 @@ func (r *RayClusterReconciler) calculateStatus(ctx context.Context, instance *ra
 	newInstance.Status.DesiredWorkerReplicas = utils.CalculateDesiredReplicas(ctx, newInstance)
 	newInstance.Status.MinWorkerReplicas = utils.CalculateMinReplicas(newInstance)
 	newInstance.Status.MaxWorkerReplicas = utils.CalculateMaxReplicas(newInstance)
+	newInstance.Status.UpdatedWorkerReplicas = utils.CalculateUpdatedReplicas(newInstance, runtimePods)
+	newInstance.Status.DrainingWorkerReplicas = utils.CalculateDrainingReplicas(runtimePods)
+	newInstance.Status.WorkerGroupStatuses = utils.CalculateWorkerGroupStatuses(ctx, newInstance, runtimePods)
 
 	totalResources := utils.CalculateDesiredResources(newInstance)
 	newInstance.Status.DesiredCPU = totalResources[corev1.ResourceCPU]
@@ -1547,7 +2630,34 @@ func (r *RayClusterReconciler) calculateStatus(ctx context.Context, instance *ra
 			meta.SetStatusCondition(&newInstance.Status.Conditions, headPodReadyCondition)
 		}
 
+		if condition, ok := utils.FindWorkerGroupsUpdatingCondition(newInstance, runtimePods); ok {
+			meta.SetStatusCondition(&newInstance.Status.Conditions, condition)
+		} else {
+			meta.RemoveStatusCondition(&newInstance.Status.Conditions, string(rayv1.RayClusterWorkerGroupsUpdating))
+		}
+
+		// The Pod failure conditions only exist while there is a failure to report, like RayClusterReplicaFailure.
+		if condition, ok := utils.FindWorkersUnschedulableCondition(runtimePods); ok {
+			meta.SetStatusCondition(&newInstance.Status.Conditions, condition)
+		} else {
+			meta.RemoveStatusCondition(&newInstance.Status.Conditions, string(rayv1.RayClusterWorkersUnschedulable))
+		}
+		if condition, ok := utils.FindImagePullFailureCondition(runtimePods); ok {
+			meta.SetStatusCondition(&newInstance.Status.Conditions, condition)
+		} else {
+			meta.RemoveStatusCondition(&newInstance.Status.Conditions, string(rayv1.RayClusterImagePullFailure))
+		}
+		if condition, ok := utils.FindHeadCrashLoopingCondition(headPod); ok {
+			meta.SetStatusCondition(&newInstance.Status.Conditions, condition)
+		} else {
+			meta.RemoveStatusCondition(&newInstance.Status.Conditions, string(rayv1.RayClusterHeadCrashLooping))
+		}
+
 		suspendStatus := utils.FindRayClusterSuspendStatus(newInstance)
+		// IdleSuspended is set when the RayCluster is suspended because it is idle, and removed once it is resumed.
+		if instance.Spec.Suspend == nil || !*instance.Spec.Suspend {
+			meta.RemoveStatusCondition(&newInstance.Status.Conditions, string(rayv1.RayClusterIdleSuspended))
+		}
 		if !meta.IsStatusConditionTrue(newInstance.Status.Conditions, string(rayv1.RayClusterProvisioned)) && suspendStatus != rayv1.RayClusterSuspended {
 			// RayClusterProvisioned indicates whether all Ray Pods are ready when the RayCluster is first created.
 			// Note RayClusterProvisioned StatusCondition will not be updated after all Ray Pods are ready for the first time. Unless the cluster has been suspended.
@@ -1621,6 +2731,12 @@ func (r *RayClusterReconciler) calculateStatus(ctx context.Context, instance *ra
 		newInstance.Status.State = rayv1.Suspended
 	}
 
+	authMode, err := utils.DetectAuthenticationMode(ctx, r, r.options.IsOpenShift)
+	if err != nil {
+		return nil, err
+	}
+	newInstance.Status.Security = utils.GetSecurityStatus(newInstance, authMode)
+
 	if err := r.updateEndpoints(ctx, newInstance); err != nil {
 		return nil, err
 	}
@@ -1703,6 +2819,16 @@ func (r *RayClusterReconciler) updateEndpoints(ctx context.Context, instance *ra
 		logger.Info("updateEndpoints: Unable to find a Service for this RayCluster. Not adding RayCluster status.endpoints", "serviceSelectors", filterLabels)
 	}
 
+	// The Ray dashboard behind the auth proxy is routed through a Gateway, whose URL follows the operator configuration.
+	if utils.IsAuthProxyEnabled(instance) {
+		if instance.Status.Endpoints == nil {
+			instance.Status.Endpoints = map[string]string{}
+		}
+		instance.Status.Endpoints[utils.DashboardURLEndpointKey] = dashboardURL(instance, r.options.DashboardGateway)
+	} else {
+		delete(instance.Status.Endpoints, utils.DashboardURLEndpointKey)
+	}
+
 	return nil
 }
 
@@ -26,12 +26,14 @@ import (
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
+	configv1 "github.com/openshift/api/config/v1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -51,7 +53,9 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
+	configapi "github.com/ray-project/kuberay/ray-operator/apis/config/v1alpha1"
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/common"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/expectations"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/metrics/mocks"
@@ -1339,6 +1343,33 @@ func TestUpdateEndpoints(t *testing.T) {
 	assert.Equal(t, expected, testRayCluster.Status.Endpoints, "RayCluster status endpoints not updated")
 }
 
+func TestUpdateEndpointsDashboardURL(t *testing.T) {
+	setupTest(t)
+
+	fakeClient := clientFake.NewClientBuilder().WithRuntimeObjects(testServices...).Build()
+	ctx := context.Background()
+	testRayClusterReconciler := &RayClusterReconciler{
+		Client:   fakeClient,
+		Recorder: &record.FakeRecorder{},
+		Scheme:   scheme.Scheme,
+		options: RayClusterReconcilerOptions{
+			DashboardGateway: &configapi.DashboardGatewayConfig{Hostname: "ray.example.com"},
+		},
+	}
+
+	// The dashboard URL is only reported when the dashboard is behind the auth proxy.
+	require.NoError(t, testRayClusterReconciler.updateEndpoints(ctx, testRayCluster))
+	assert.NotContains(t, testRayCluster.Status.Endpoints, utils.DashboardURLEndpointKey)
+
+	testRayCluster.Spec.SecurityOptions = &rayv1.SecurityOptions{AuthProxy: ptr.To(true)}
+	require.NoError(t, testRayClusterReconciler.updateEndpoints(ctx, testRayCluster))
+	assert.Equal(t, "https://ray.example.com/ray/"+namespaceStr+"/"+instanceName, testRayCluster.Status.Endpoints[utils.DashboardURLEndpointKey])
+
+	testRayCluster.Spec.SecurityOptions.AuthProxy = ptr.To(false)
+	require.NoError(t, testRayClusterReconciler.updateEndpoints(ctx, testRayCluster))
+	assert.NotContains(t, testRayCluster.Status.Endpoints, utils.DashboardURLEndpointKey)
+}
+
 func TestGetHeadPodIPAndNameFromGetRayClusterHeadPod(t *testing.T) {
 	setupTest(t)
 
@@ -1699,6 +1730,13 @@ func TestInconsistentRayClusterStatus(t *testing.T) {
 			},
 			expectResult: true,
 		},
+		{
+			name: "WorkerGroupStatuses is updated, expect result to be true",
+			modifyStatus: func(newStatus *rayv1.RayClusterStatus) {
+				newStatus.WorkerGroupStatuses = []rayv1.WorkerGroupStatus{{GroupName: "small-group", PendingReplicas: 1}}
+			},
+			expectResult: true,
+		},
 		{
 			name: "Endpoints is updated, expect result to be true",
 			modifyStatus: func(newStatus *rayv1.RayClusterStatus) {
@@ -1984,6 +2022,177 @@ func TestCalculateStatusWithSuspendedWorkerGroups(t *testing.T) {
 	assert.NotNil(t, newInstance.Status.StateTransitionTimes)
 }
 
+func TestCalculateStatusWithWorkerGroupUpdateStrategy(t *testing.T) {
+	setupTest(t)
+
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	cluster := testRayCluster.DeepCopy()
+	cluster.Spec.WorkerGroupSpecs[0].UpdateStrategy = &rayv1.WorkerGroupUpdateStrategy{
+		Type: ptr.To(rayv1.RollingUpdateStrategy),
+	}
+	templateHash, err := utils.GenerateWorkerGroupTemplateHash(cluster.Spec.WorkerGroupSpecs[0])
+	require.NoError(t, err)
+	headService, err := common.BuildServiceForHeadPod(context.Background(), *cluster, nil, nil)
+	require.NoError(t, err, "Failed to build head service.")
+
+	runtimeObjects := func(workerPodHashes ...string) []runtime.Object {
+		objects := []runtime.Object{headService}
+		for i, hash := range workerPodHashes {
+			objects = append(objects, &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "workerNode-" + strconv.Itoa(i),
+					Namespace: namespaceStr,
+					Labels: map[string]string{
+						utils.RayClusterLabelKey:   instanceName,
+						utils.RayNodeTypeLabelKey:  string(rayv1.WorkerNode),
+						utils.RayNodeGroupLabelKey: groupNameStr,
+					},
+					Annotations: map[string]string{
+						utils.RayWorkerGroupTemplateHashKey: hash,
+					},
+				},
+			})
+		}
+		return objects
+	}
+
+	// 1 Pod is updated, and 2 Pods are created from an outdated template.
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(runtimeObjects(templateHash, "outdated", "outdated")...).Build()
+	r := &RayClusterReconciler{
+		Client:   fakeClient,
+		Recorder: &record.FakeRecorder{},
+		Scheme:   scheme.Scheme,
+	}
+	newInstance, err := r.calculateStatus(context.Background(), cluster, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), newInstance.Status.UpdatedWorkerReplicas)
+	condition := meta.FindStatusCondition(newInstance.Status.Conditions, string(rayv1.RayClusterWorkerGroupsUpdating))
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, rayv1.WorkerPodsOutdated, condition.Reason)
+
+	// All Pods are updated.
+	r.Client = clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(runtimeObjects(templateHash, templateHash, templateHash)...).Build()
+	newInstance, err = r.calculateStatus(context.Background(), cluster, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), newInstance.Status.UpdatedWorkerReplicas)
+	assert.True(t, meta.IsStatusConditionPresentAndEqual(newInstance.Status.Conditions, string(rayv1.RayClusterWorkerGroupsUpdating), metav1.ConditionFalse))
+
+	// The condition is removed if no worker group has an update strategy.
+	cluster.Spec.WorkerGroupSpecs[0].UpdateStrategy = nil
+	newInstance.Spec = cluster.Spec
+	newInstance, err = r.calculateStatus(context.Background(), newInstance, nil)
+	require.NoError(t, err)
+	assert.Nil(t, meta.FindStatusCondition(newInstance.Status.Conditions, string(rayv1.RayClusterWorkerGroupsUpdating)))
+}
+
+func TestCalculateStatusWithPodFailureConditions(t *testing.T) {
+	setupTest(t)
+
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	headService, err := common.BuildServiceForHeadPod(context.Background(), *testRayCluster, nil, nil)
+	require.NoError(t, err, "Failed to build head service.")
+	headPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "headNode",
+			Namespace: namespaceStr,
+			Labels: map[string]string{
+				utils.RayClusterLabelKey:   instanceName,
+				utils.RayNodeTypeLabelKey:  string(rayv1.HeadNode),
+				utils.RayNodeGroupLabelKey: utils.RayNodeHeadGroupLabelValue,
+			},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:         "ray-head",
+					RestartCount: 5,
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff", Message: "back-off 5m0s"},
+					},
+				},
+			},
+		},
+	}
+	workerPod := func(name string, status corev1.PodStatus) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespaceStr,
+				Labels: map[string]string{
+					utils.RayClusterLabelKey:   instanceName,
+					utils.RayNodeTypeLabelKey:  string(rayv1.WorkerNode),
+					utils.RayNodeGroupLabelKey: groupNameStr,
+				},
+			},
+			Status: status,
+		}
+	}
+	unschedulableStatus := corev1.PodStatus{
+		Phase: corev1.PodPending,
+		Conditions: []corev1.PodCondition{
+			{Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Reason: corev1.PodReasonUnschedulable},
+		},
+	}
+	imagePullStatus := corev1.PodStatus{
+		Phase: corev1.PodPending,
+		ContainerStatuses: []corev1.ContainerStatus{
+			{State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}}},
+		},
+	}
+
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(
+		headService,
+		headPod,
+		workerPod("workerNode-0", unschedulableStatus),
+		workerPod("workerNode-1", unschedulableStatus),
+		workerPod("workerNode-2", imagePullStatus),
+	).Build()
+	r := &RayClusterReconciler{
+		Client:   fakeClient,
+		Recorder: &record.FakeRecorder{},
+		Scheme:   scheme.Scheme,
+	}
+	newInstance, err := r.calculateStatus(context.Background(), testRayCluster, nil)
+	require.NoError(t, err)
+
+	condition := meta.FindStatusCondition(newInstance.Status.Conditions, string(rayv1.RayClusterWorkersUnschedulable))
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, rayv1.WorkerPodsUnschedulable, condition.Reason)
+	assert.Equal(t, "Unschedulable worker Pods by group: "+groupNameStr+" (2)", condition.Message)
+
+	condition = meta.FindStatusCondition(newInstance.Status.Conditions, string(rayv1.RayClusterImagePullFailure))
+	require.NotNil(t, condition)
+	assert.Equal(t, rayv1.RayPodsImagePullFailed, condition.Reason)
+	assert.Equal(t, "Pods failing to pull images by group: "+groupNameStr+" (1)", condition.Message)
+
+	condition = meta.FindStatusCondition(newInstance.Status.Conditions, string(rayv1.RayClusterHeadCrashLooping))
+	require.NotNil(t, condition)
+	assert.Equal(t, rayv1.HeadPodCrashLoopBackOff, condition.Reason)
+	assert.Contains(t, condition.Message, "Container ray-head of head Pod headNode is in CrashLoopBackOff after 5 restarts")
+
+	// The conditions are removed once the Pods recover.
+	headPod.Status = corev1.PodStatus{Phase: corev1.PodRunning}
+	r.Client = clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(
+		headService,
+		headPod,
+		workerPod("workerNode-0", corev1.PodStatus{Phase: corev1.PodRunning}),
+	).Build()
+	newInstance, err = r.calculateStatus(context.Background(), newInstance, nil)
+	require.NoError(t, err)
+	assert.Nil(t, meta.FindStatusCondition(newInstance.Status.Conditions, string(rayv1.RayClusterWorkersUnschedulable)))
+	assert.Nil(t, meta.FindStatusCondition(newInstance.Status.Conditions, string(rayv1.RayClusterImagePullFailure)))
+	assert.Nil(t, meta.FindStatusCondition(newInstance.Status.Conditions, string(rayv1.RayClusterHeadCrashLooping)))
+}
+
 // TestCalculateStatusWithReconcileErrorBackAndForth tests that the cluster CR should not be marked as Ready if reconcileErr != nil
 // and the Ready state should not be removed after being Ready even if reconcileErr != nil
 func TestCalculateStatusWithReconcileErrorBackAndForth(t *testing.T) {
@@ -2776,6 +2985,7 @@ func Test_RedisCleanupFeatureFlag(t *testing.T) {
 	newScheme := runtime.NewScheme()
 	_ = rayv1.AddToScheme(newScheme)
 	_ = corev1.AddToScheme(newScheme)
+	_ = policyv1.AddToScheme(newScheme)
 
 	// Prepare a RayCluster with the GCS FT enabled and Autoscaling disabled.
 	gcsFTEnabledCluster := testRayCluster.DeepCopy()
@@ -2885,6 +3095,7 @@ func TestEvents_RedisCleanup(t *testing.T) {
 	_ = rayv1.AddToScheme(newScheme)
 	_ = corev1.AddToScheme(newScheme)
 	_ = batchv1.AddToScheme(newScheme)
+	_ = policyv1.AddToScheme(newScheme)
 
 	// Prepare a RayCluster with the GCS FT enabled and Autoscaling disabled.
 	gcsFTEnabledCluster := testRayCluster.DeepCopy()
@@ -2974,6 +3185,7 @@ func Test_RedisCleanup(t *testing.T) {
 	_ = rayv1.AddToScheme(newScheme)
 	_ = corev1.AddToScheme(newScheme)
 	_ = batchv1.AddToScheme(newScheme)
+	_ = policyv1.AddToScheme(newScheme)
 
 	// Prepare a RayCluster with the GCS FT enabled and Autoscaling disabled.
 	gcsFTEnabledCluster := testRayCluster.DeepCopy()
@@ -3325,6 +3537,90 @@ func TestReconcile_Multihost_Replicas(t *testing.T) {
 	}
 }
 
+func TestReconcile_MultihostReplicaAtomicity(t *testing.T) {
+	setupTest(t)
+
+	cluster := testRayCluster.DeepCopy()
+	cluster.Spec.EnableInTreeAutoscaling = ptr.To(false)
+	cluster.Spec.WorkerGroupSpecs[0].ScaleStrategy.WorkersToDelete = []string{}
+	cluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](2)
+	cluster.Spec.WorkerGroupSpecs[0].NumOfHosts = 2
+
+	fakeClient := clientFake.NewClientBuilder().Build()
+	ctx := context.Background()
+	testRayClusterReconciler := &RayClusterReconciler{
+		Client:                     fakeClient,
+		Recorder:                   &record.FakeRecorder{},
+		Scheme:                     scheme.Scheme,
+		rayClusterScaleExpectation: expectations.NewRayClusterScaleExpectation(fakeClient),
+	}
+	listReplicas := func() map[int][]corev1.Pod {
+		podList := corev1.PodList{}
+		err := fakeClient.List(ctx, &podList, client.InNamespace(namespaceStr), client.MatchingLabels{utils.RayNodeGroupLabelKey: groupNameStr})
+		require.NoError(t, err)
+		replicas, unindexed := utils.GroupPodsByReplicaIndex(podList.Items)
+		assert.Empty(t, unindexed, "all the Pods of a multi-host group should have a replica index")
+		return replicas
+	}
+
+	// Each replica is created with NumOfHosts Pods that share a replica index and have distinct host indices.
+	err := testRayClusterReconciler.reconcilePods(ctx, cluster)
+	require.NoError(t, err)
+	replicas := listReplicas()
+	require.Len(t, replicas, 2)
+	for index := range 2 {
+		require.Len(t, replicas[index], 2)
+		hostIndices := []string{
+			replicas[index][0].Labels[utils.RayHostIndexKey],
+			replicas[index][1].Labels[utils.RayHostIndexKey],
+		}
+		assert.ElementsMatch(t, []string{"0", "1"}, hostIndices)
+	}
+
+	// If a host of a replica fails, all the Pods of the replica are deleted, and the replica is recreated with the same index.
+	failedPod := replicas[0][0]
+	failedPod.Status.Phase = corev1.PodFailed
+	err = fakeClient.Status().Update(ctx, &failedPod)
+	require.NoError(t, err)
+	healthyReplicaPods := replicas[1]
+
+	err = testRayClusterReconciler.reconcilePods(ctx, cluster)
+	require.Error(t, err)
+	replicas = listReplicas()
+	assert.Len(t, replicas, 1)
+	assert.ElementsMatch(t, healthyReplicaPods, replicas[1])
+
+	err = testRayClusterReconciler.reconcilePods(ctx, cluster)
+	require.NoError(t, err)
+	replicas = listReplicas()
+	require.Len(t, replicas, 2)
+	assert.Len(t, replicas[0], 2)
+	assert.NotContains(t, []string{replicas[0][0].Name, replicas[0][1].Name}, failedPod.Name)
+
+	// Deleting a single host through WorkersToDelete scales down its whole replica.
+	cluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](1)
+	cluster.Spec.WorkerGroupSpecs[0].ScaleStrategy.WorkersToDelete = []string{replicas[1][0].Name}
+	err = testRayClusterReconciler.reconcilePods(ctx, cluster)
+	require.NoError(t, err)
+	replicas = listReplicas()
+	require.Len(t, replicas, 1)
+	assert.Len(t, replicas[0], 2)
+
+	// Random scale-down also deletes whole replicas.
+	cluster.Spec.WorkerGroupSpecs[0].ScaleStrategy.WorkersToDelete = []string{}
+	cluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](2)
+	err = testRayClusterReconciler.reconcilePods(ctx, cluster)
+	require.NoError(t, err)
+	require.Len(t, listReplicas(), 2)
+
+	cluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](1)
+	err = testRayClusterReconciler.reconcilePods(ctx, cluster)
+	require.NoError(t, err)
+	replicas = listReplicas()
+	require.Len(t, replicas, 1)
+	assert.Len(t, replicas[0], 2, "the replica with the highest index should be deleted as a whole")
+}
+
 func TestReconcile_NumOfHosts(t *testing.T) {
 	setupTest(t)
 
@@ -3400,83 +3696,293 @@ func TestReconcile_NumOfHosts(t *testing.T) {
 	}
 }
 
-func TestSumGPUs(t *testing.T) {
-	nvidiaGPUResourceName := corev1.ResourceName("nvidia.com/gpu")
-	googleTPUResourceName := corev1.ResourceName("google.com/tpu")
+func TestReconcile_WorkerGroupUpdateStrategy(t *testing.T) {
+	setupTest(t)
+
+	// This test makes some assumptions about the testRayCluster object.
+	// (1) 1 workerGroup (2) disable autoscaling
+	assert.Len(t, testRayCluster.Spec.WorkerGroupSpecs, 1, "This test assumes only one worker group.")
+	testRayCluster.Spec.EnableInTreeAutoscaling = ptr.To(false)
+	testRayCluster.Spec.WorkerGroupSpecs[0].ScaleStrategy.WorkersToDelete = []string{}
+	testRayCluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](4)
+
+	outdatedWorkerPod := func(name string, ready bool) *corev1.Pod {
+		readyStatus := corev1.ConditionFalse
+		if ready {
+			readyStatus = corev1.ConditionTrue
+		}
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespaceStr,
+				Labels: map[string]string{
+					utils.RayNodeLabelKey:      "yes",
+					utils.RayClusterLabelKey:   instanceName,
+					utils.RayNodeTypeLabelKey:  string(rayv1.WorkerNode),
+					utils.RayNodeGroupLabelKey: groupNameStr,
+				},
+				Annotations: map[string]string{
+					utils.RayWorkerGroupTemplateHashKey: "outdated",
+				},
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "ray-worker", Image: "rayproject/ray:2.9.0"}},
+			},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				Conditions: []corev1.PodCondition{
+					{
+						Type:   corev1.PodReady,
+						Status: readyStatus,
+					},
+				},
+			},
+		}
+	}
 
 	tests := []struct {
-		name     string
-		input    map[corev1.ResourceName]resource.Quantity
-		expected resource.Quantity
+		updateStrategy      *rayv1.WorkerGroupUpdateStrategy
+		name                string
+		numUnreadyPods      int
+		expectedNumPods     int
+		expectedUpdatedPods int
 	}{
 		{
-			name: "no GPUs specified",
-			input: map[corev1.ResourceName]resource.Quantity{
-				corev1.ResourceCPU: resource.MustParse("1"),
+			name:                "OnDelete keeps outdated Pods",
+			updateStrategy:      nil,
+			expectedNumPods:     4,
+			expectedUpdatedPods: 0,
+		},
+		{
+			name: "Recreate deletes all outdated Pods",
+			updateStrategy: &rayv1.WorkerGroupUpdateStrategy{
+				Type: ptr.To(rayv1.RecreateUpdateStrategy),
 			},
-			expected: resource.MustParse("0"),
+			expectedNumPods:     0,
+			expectedUpdatedPods: 0,
 		},
 		{
-			name: "one GPU type specified",
-			input: map[corev1.ResourceName]resource.Quantity{
-				corev1.ResourceCPU:    resource.MustParse("1"),
-				nvidiaGPUResourceName: resource.MustParse("1"),
-				googleTPUResourceName: resource.MustParse("1"),
+			name: "RollingUpdate surges before deleting ready Pods",
+			updateStrategy: &rayv1.WorkerGroupUpdateStrategy{
+				Type: ptr.To(rayv1.RollingUpdateStrategy),
+				RollingUpdate: &rayv1.RollingUpdateWorkerGroupStrategy{
+					MaxSurge:       ptr.To(intstr.FromInt32(1)),
+					MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+				},
 			},
-			expected: resource.MustParse("1"),
+			expectedNumPods:     5,
+			expectedUpdatedPods: 1,
 		},
 		{
-			name: "multiple GPUs specified",
-			input: map[corev1.ResourceName]resource.Quantity{
-				corev1.ResourceCPU:                 resource.MustParse("1"),
-				nvidiaGPUResourceName:              resource.MustParse("3"),
-				corev1.ResourceName("foo.bar/gpu"): resource.MustParse("2"),
-				googleTPUResourceName:              resource.MustParse("1"),
+			name: "RollingUpdate deletes at most maxUnavailable ready Pods",
+			updateStrategy: &rayv1.WorkerGroupUpdateStrategy{
+				Type: ptr.To(rayv1.RollingUpdateStrategy),
+				RollingUpdate: &rayv1.RollingUpdateWorkerGroupStrategy{
+					MaxSurge:       ptr.To(intstr.FromInt32(0)),
+					MaxUnavailable: ptr.To(intstr.FromInt32(2)),
+				},
 			},
-			expected: resource.MustParse("5"),
+			expectedNumPods:     2,
+			expectedUpdatedPods: 0,
+		},
+		{
+			name: "RollingUpdate deletes outdated Pods that are not ready",
+			updateStrategy: &rayv1.WorkerGroupUpdateStrategy{
+				Type: ptr.To(rayv1.RollingUpdateStrategy),
+				RollingUpdate: &rayv1.RollingUpdateWorkerGroupStrategy{
+					MaxSurge:       ptr.To(intstr.FromInt32(1)),
+					MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+				},
+			},
+			numUnreadyPods:      2,
+			expectedNumPods:     3,
+			expectedUpdatedPods: 1,
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			result := sumGPUs(tc.input)
-			assert.True(t, tc.expected.Equal(result), "GPU number is wrong")
+			cluster := testRayCluster.DeepCopy()
+			cluster.Spec.WorkerGroupSpecs[0].UpdateStrategy = tc.updateStrategy
+
+			// The fake client starts with 1 head Pod and 4 worker Pods created from an outdated template.
+			runtimeObjects := []runtime.Object{testPods[0]}
+			for i := 0; i < 4; i++ {
+				runtimeObjects = append(runtimeObjects, outdatedWorkerPod("worker-"+strconv.Itoa(i), i >= tc.numUnreadyPods))
+			}
+			fakeClient := clientFake.NewClientBuilder().WithRuntimeObjects(runtimeObjects...).Build()
+			ctx := context.Background()
+
+			testRayClusterReconciler := &RayClusterReconciler{
+				Client:                     fakeClient,
+				Recorder:                   &record.FakeRecorder{},
+				Scheme:                     scheme.Scheme,
+				rayClusterScaleExpectation: expectations.NewRayClusterScaleExpectation(fakeClient),
+			}
+
+			err := testRayClusterReconciler.reconcilePods(ctx, cluster)
+			require.NoError(t, err, "Fail to reconcile Pods")
+
+			podList := corev1.PodList{}
+			err = fakeClient.List(ctx, &podList, &client.ListOptions{
+				LabelSelector: workerSelector,
+				Namespace:     namespaceStr,
+			})
+			require.NoError(t, err, "Fail to get pod list after reconcile")
+			assert.Len(t, podList.Items, tc.expectedNumPods)
+
+			templateHash, err := utils.GenerateWorkerGroupTemplateHash(cluster.Spec.WorkerGroupSpecs[0])
+			require.NoError(t, err)
+			numUpdatedPods := 0
+			for _, pod := range podList.Items {
+				if !utils.IsWorkerPodOutdated(pod, templateHash) {
+					numUpdatedPods++
+				}
+			}
+			assert.Equal(t, tc.expectedUpdatedPods, numUpdatedPods)
 		})
 	}
 }
 
-func TestDeleteAllPods(t *testing.T) {
-	newScheme := runtime.NewScheme()
-	_ = corev1.AddToScheme(newScheme)
-	ns := "tmp-ns"
-	ts := metav1.Now()
-	filter := map[string]string{"app": "tmp"}
+func TestReconcile_WorkerGroupUpdateStrategyBackfillsTemplateHash(t *testing.T) {
+	setupTest(t)
 
-	p1 := &corev1.Pod{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      "alive",
-			Namespace: ns,
-			Labels:    filter,
-		},
-	}
-	p2 := &corev1.Pod{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:              "deleted",
-			Namespace:         ns,
-			Labels:            filter,
-			DeletionTimestamp: &ts,
-			Finalizers:        []string{"tmp"},
-		},
-	}
-	p3 := &corev1.Pod{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      "other",
-			Namespace: ns,
-			Labels:    map[string]string{"app": "other"},
-		},
+	testRayCluster.Spec.EnableInTreeAutoscaling = ptr.To(false)
+	testRayCluster.Spec.WorkerGroupSpecs[0].ScaleStrategy.WorkersToDelete = []string{}
+	testRayCluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](4)
+	cluster := testRayCluster.DeepCopy()
+	cluster.Spec.WorkerGroupSpecs[0].UpdateStrategy = &rayv1.WorkerGroupUpdateStrategy{
+		Type: ptr.To(rayv1.RecreateUpdateStrategy),
 	}
 
-	fakeClient := clientFake.NewClientBuilder().
+	// The worker Pods were created before the update strategy was enabled, so they don't have the template hash annotation.
+	runtimeObjects := []runtime.Object{testPods[0]}
+	for i := 0; i < 4; i++ {
+		runtimeObjects = append(runtimeObjects, &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "worker-" + strconv.Itoa(i),
+				Namespace: namespaceStr,
+				Labels: map[string]string{
+					utils.RayNodeLabelKey:      "yes",
+					utils.RayClusterLabelKey:   instanceName,
+					utils.RayNodeTypeLabelKey:  string(rayv1.WorkerNode),
+					utils.RayNodeGroupLabelKey: groupNameStr,
+				},
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "ray-worker", Image: "rayproject/ray:2.9.0"}},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		})
+	}
+	fakeClient := clientFake.NewClientBuilder().WithRuntimeObjects(runtimeObjects...).Build()
+	ctx := context.Background()
+	testRayClusterReconciler := &RayClusterReconciler{
+		Client:                     fakeClient,
+		Recorder:                   &record.FakeRecorder{},
+		Scheme:                     scheme.Scheme,
+		rayClusterScaleExpectation: expectations.NewRayClusterScaleExpectation(fakeClient),
+	}
+
+	// Enabling the update strategy keeps the existing Pods and backfills their template hash.
+	err := testRayClusterReconciler.reconcilePods(ctx, cluster)
+	require.NoError(t, err, "Fail to reconcile Pods")
+	templateHash, err := utils.GenerateWorkerGroupTemplateHash(cluster.Spec.WorkerGroupSpecs[0])
+	require.NoError(t, err)
+	podList := corev1.PodList{}
+	err = fakeClient.List(ctx, &podList, &client.ListOptions{LabelSelector: workerSelector, Namespace: namespaceStr})
+	require.NoError(t, err, "Fail to get pod list after reconcile")
+	assert.Len(t, podList.Items, 4)
+	for _, pod := range podList.Items {
+		assert.Equal(t, templateHash, pod.Annotations[utils.RayWorkerGroupTemplateHashKey])
+	}
+
+	// A later template change is detected through the backfilled annotation.
+	cluster.Spec.WorkerGroupSpecs[0].Template.Spec.Containers[0].Image = "rayproject/ray:2.47.0"
+	err = testRayClusterReconciler.reconcilePods(ctx, cluster)
+	require.NoError(t, err, "Fail to reconcile Pods")
+	err = fakeClient.List(ctx, &podList, &client.ListOptions{LabelSelector: workerSelector, Namespace: namespaceStr})
+	require.NoError(t, err, "Fail to get pod list after reconcile")
+	assert.Empty(t, podList.Items)
+}
+
+func TestSumGPUs(t *testing.T) {
+	nvidiaGPUResourceName := corev1.ResourceName("nvidia.com/gpu")
+	googleTPUResourceName := corev1.ResourceName("google.com/tpu")
+
+	tests := []struct {
+		name     string
+		input    map[corev1.ResourceName]resource.Quantity
+		expected resource.Quantity
+	}{
+		{
+			name: "no GPUs specified",
+			input: map[corev1.ResourceName]resource.Quantity{
+				corev1.ResourceCPU: resource.MustParse("1"),
+			},
+			expected: resource.MustParse("0"),
+		},
+		{
+			name: "one GPU type specified",
+			input: map[corev1.ResourceName]resource.Quantity{
+				corev1.ResourceCPU:    resource.MustParse("1"),
+				nvidiaGPUResourceName: resource.MustParse("1"),
+				googleTPUResourceName: resource.MustParse("1"),
+			},
+			expected: resource.MustParse("1"),
+		},
+		{
+			name: "multiple GPUs specified",
+			input: map[corev1.ResourceName]resource.Quantity{
+				corev1.ResourceCPU:                 resource.MustParse("1"),
+				nvidiaGPUResourceName:              resource.MustParse("3"),
+				corev1.ResourceName("foo.bar/gpu"): resource.MustParse("2"),
+				googleTPUResourceName:              resource.MustParse("1"),
+			},
+			expected: resource.MustParse("5"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := sumGPUs(tc.input)
+			assert.True(t, tc.expected.Equal(result), "GPU number is wrong")
+		})
+	}
+}
+
+func TestDeleteAllPods(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(newScheme)
+	ns := "tmp-ns"
+	ts := metav1.Now()
+	filter := map[string]string{"app": "tmp"}
+
+	p1 := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "alive",
+			Namespace: ns,
+			Labels:    filter,
+		},
+	}
+	p2 := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "deleted",
+			Namespace:         ns,
+			Labels:            filter,
+			DeletionTimestamp: &ts,
+			Finalizers:        []string{"tmp"},
+		},
+	}
+	p3 := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "other",
+			Namespace: ns,
+			Labels:    map[string]string{"app": "other"},
+		},
+	}
+
+	fakeClient := clientFake.NewClientBuilder().
 		WithScheme(newScheme).
 		WithRuntimeObjects(p1, p2, p3).
 		Build()
@@ -3623,6 +4129,7 @@ func Test_ReconcileManagedBy(t *testing.T) {
 	_ = rayv1.AddToScheme(newScheme)
 	_ = corev1.AddToScheme(newScheme)
 	_ = batchv1.AddToScheme(newScheme)
+	_ = policyv1.AddToScheme(newScheme)
 
 	tests := []struct {
 		managedBy       *string
@@ -3799,3 +4306,905 @@ func TestSetDefaults(t *testing.T) {
 		assert.Equal(t, map[string]string{}, cluster.Spec.WorkerGroupSpecs[i].RayStartParams)
 	}
 }
+
+func TestReconcilePodDisruptionBudgets(t *testing.T) {
+	setupTest(t)
+
+	testRayCluster.UID = types.UID("raycluster-sample-uid")
+	testRayCluster.Spec.HeadGroupSpec.DisruptionBudget = &rayv1.DisruptionBudget{
+		MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+	}
+	testRayCluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](3)
+	testRayCluster.Spec.WorkerGroupSpecs[0].NumOfHosts = 2
+	testRayCluster.Spec.WorkerGroupSpecs[0].DisruptionBudget = &rayv1.DisruptionBudget{
+		MinAvailable: ptr.To(intstr.FromInt32(2)),
+	}
+
+	// A PodDisruptionBudget that is not owned by the RayCluster should be left untouched.
+	unmanagedPDB := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "unmanaged-pdb",
+			Namespace: namespaceStr,
+			Labels: map[string]string{
+				utils.RayClusterLabelKey:          instanceName,
+				utils.KubernetesCreatedByLabelKey: utils.ComponentName,
+			},
+		},
+	}
+
+	fakeClient := clientFake.NewClientBuilder().WithRuntimeObjects(unmanagedPDB).Build()
+	ctx := context.Background()
+	testRayClusterReconciler := &RayClusterReconciler{
+		Client:   fakeClient,
+		Recorder: &record.FakeRecorder{},
+		Scheme:   scheme.Scheme,
+	}
+
+	getPDB := func(groupName string) (*policyv1.PodDisruptionBudget, error) {
+		pdb := &policyv1.PodDisruptionBudget{}
+		err := fakeClient.Get(ctx, types.NamespacedName{
+			Namespace: namespaceStr,
+			Name:      utils.GenerateDisruptionBudgetName(instanceName, groupName),
+		}, pdb)
+		return pdb, err
+	}
+
+	err := testRayClusterReconciler.reconcilePodDisruptionBudgets(ctx, testRayCluster)
+	require.NoError(t, err)
+
+	headPDB, err := getPDB(utils.RayNodeHeadGroupLabelValue)
+	require.NoError(t, err)
+	assert.Equal(t, ptr.To(intstr.FromInt32(1)), headPDB.Spec.MinAvailable)
+	assert.True(t, metav1.IsControlledBy(headPDB, testRayCluster))
+
+	// 3 replicas of 2 hosts with minAvailable 2 allow a single Pod to be evicted.
+	workerPDB, err := getPDB(groupNameStr)
+	require.NoError(t, err)
+	assert.Equal(t, ptr.To(intstr.FromInt32(5)), workerPDB.Spec.MinAvailable)
+	assert.Equal(t, map[string]string{
+		utils.RayClusterLabelKey:   instanceName,
+		utils.RayNodeGroupLabelKey: groupNameStr,
+	}, workerPDB.Spec.Selector.MatchLabels)
+
+	// Scaling the worker group updates the PodDisruptionBudget, and removing the head group budget deletes its PDB.
+	testRayCluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](5)
+	testRayCluster.Spec.HeadGroupSpec.DisruptionBudget = nil
+	err = testRayClusterReconciler.reconcilePodDisruptionBudgets(ctx, testRayCluster)
+	require.NoError(t, err)
+
+	workerPDB, err = getPDB(groupNameStr)
+	require.NoError(t, err)
+	assert.Equal(t, ptr.To(intstr.FromInt32(7)), workerPDB.Spec.MinAvailable)
+
+	_, err = getPDB(utils.RayNodeHeadGroupLabelValue)
+	assert.True(t, k8serrors.IsNotFound(err), "the head group PodDisruptionBudget should be deleted")
+
+	err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespaceStr, Name: unmanagedPDB.Name}, &policyv1.PodDisruptionBudget{})
+	require.NoError(t, err, "PodDisruptionBudgets not owned by the RayCluster should not be deleted")
+
+	// Removing the last budget from the spec deletes its PDB.
+	testRayCluster.Spec.WorkerGroupSpecs[0].DisruptionBudget = nil
+	testRayCluster.Generation = 2
+	testRayCluster.Status.ObservedGeneration = 1
+	err = testRayClusterReconciler.reconcilePodDisruptionBudgets(ctx, testRayCluster)
+	require.NoError(t, err)
+	_, err = getPDB(groupNameStr)
+	assert.True(t, k8serrors.IsNotFound(err), "the worker group PodDisruptionBudget should be deleted")
+
+	// PodDisruptionBudgets aren't listed once the spec without budgets has been observed.
+	testRayCluster.Status.ObservedGeneration = 2
+	testRayClusterReconciler.Client = interceptor.NewClient(fakeClient, interceptor.Funcs{
+		List: func(_ context.Context, _ client.WithWatch, _ client.ObjectList, _ ...client.ListOption) error {
+			return errors.New("PodDisruptionBudgets should not be listed")
+		},
+	})
+	err = testRayClusterReconciler.reconcilePodDisruptionBudgets(ctx, testRayCluster)
+	require.NoError(t, err)
+}
+
+func TestCreateWorkerPodWithVolumeClaimTemplates(t *testing.T) {
+	setupTest(t)
+
+	testRayCluster.UID = types.UID("raycluster-sample-uid")
+	worker := testRayCluster.Spec.WorkerGroupSpecs[0]
+	worker.VolumeClaimTemplates = []corev1.PersistentVolumeClaim{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "data"},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+				},
+			},
+		},
+	}
+	testRayCluster.Spec.WorkerGroupSpecs[0] = worker
+
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).Build()
+	ctx := context.Background()
+	testRayClusterReconciler := &RayClusterReconciler{
+		Client:                     fakeClient,
+		Recorder:                   &record.FakeRecorder{},
+		Scheme:                     newScheme,
+		rayClusterScaleExpectation: expectations.NewRayClusterScaleExpectation(fakeClient),
+	}
+
+	claimIndices, err := testRayClusterReconciler.newVolumeClaimIndices(ctx, testRayCluster, groupNameStr, worker.VolumeClaimTemplates, nil)
+	require.NoError(t, err)
+	err = testRayClusterReconciler.createWorkerPod(ctx, *testRayCluster, worker, claimIndices, nil)
+	require.NoError(t, err)
+
+	podList := corev1.PodList{}
+	err = fakeClient.List(ctx, &podList, client.InNamespace(namespaceStr), client.MatchingLabels{utils.RayNodeGroupLabelKey: groupNameStr})
+	require.NoError(t, err)
+	require.Len(t, podList.Items, 1)
+	pod := podList.Items[0]
+
+	// The Pod mounts a claim named after the template, the group and the volume claim index of the Pod, and the claim
+	// is owned by the Pod by default.
+	claimName := utils.GenerateVolumeClaimName("data", instanceName, groupNameStr, 0)
+	assert.Equal(t, "0", pod.Labels[utils.RayVolumeClaimIndexKey])
+	var volume *corev1.Volume
+	for i := range pod.Spec.Volumes {
+		if pod.Spec.Volumes[i].Name == "data" {
+			volume = &pod.Spec.Volumes[i]
+		}
+	}
+	require.NotNil(t, volume)
+	assert.Equal(t, claimName, volume.PersistentVolumeClaim.ClaimName)
+
+	pvc := corev1.PersistentVolumeClaim{}
+	err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespaceStr, Name: claimName}, &pvc)
+	require.NoError(t, err)
+	assert.Equal(t, instanceName, pvc.Labels[utils.RayClusterLabelKey])
+	assert.Equal(t, groupNameStr, pvc.Labels[utils.RayNodeGroupLabelKey])
+	require.Len(t, pvc.OwnerReferences, 1)
+	assert.Equal(t, pod.Name, pvc.OwnerReferences[0].Name)
+
+	// A claim that is missing, e.g. because it was deleted or could not be created, is created again.
+	err = fakeClient.Delete(ctx, &pvc)
+	require.NoError(t, err)
+	testRayCluster.Spec.WorkerGroupSpecs[0].VolumeClaimRetentionPolicy = &rayv1.VolumeClaimRetentionPolicy{
+		WhenPodDeleted:     ptr.To(rayv1.RetainVolumeClaimRetentionPolicy),
+		WhenClusterDeleted: ptr.To(rayv1.DeleteVolumeClaimRetentionPolicy),
+	}
+	err = testRayClusterReconciler.reconcileVolumeClaims(ctx, testRayCluster)
+	require.NoError(t, err)
+
+	pvc = corev1.PersistentVolumeClaim{}
+	err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespaceStr, Name: claimName}, &pvc)
+	require.NoError(t, err)
+	assert.True(t, metav1.IsControlledBy(&pvc, testRayCluster), "the claim should be owned by the RayCluster when it is retained after the Pod is deleted")
+
+	// The Pod replacing a deleted Pod gets its volume claim index and mounts the retained claim.
+	err = fakeClient.Delete(ctx, &pod)
+	require.NoError(t, err)
+	claimIndices, err = testRayClusterReconciler.newVolumeClaimIndices(ctx, testRayCluster, groupNameStr, worker.VolumeClaimTemplates, nil)
+	require.NoError(t, err)
+	err = testRayClusterReconciler.createWorkerPod(ctx, *testRayCluster, testRayCluster.Spec.WorkerGroupSpecs[0], claimIndices, nil)
+	require.NoError(t, err)
+
+	podList = corev1.PodList{}
+	err = fakeClient.List(ctx, &podList, client.InNamespace(namespaceStr), client.MatchingLabels{utils.RayNodeGroupLabelKey: groupNameStr})
+	require.NoError(t, err)
+	require.Len(t, podList.Items, 1)
+	claimName, ok := common.GetVolumeClaimName(&podList.Items[0], "data")
+	require.True(t, ok)
+	assert.Equal(t, pvc.Name, claimName)
+	claimList := corev1.PersistentVolumeClaimList{}
+	err = fakeClient.List(ctx, &claimList, client.InNamespace(namespaceStr))
+	require.NoError(t, err)
+	assert.Len(t, claimList.Items, 1)
+}
+
+func TestNewVolumeClaimIndices(t *testing.T) {
+	setupTest(t)
+
+	templates := []corev1.PersistentVolumeClaim{{ObjectMeta: metav1.ObjectMeta{Name: "data"}}}
+	claim := func(index string, groupName string, mutate func(*corev1.PersistentVolumeClaim)) *corev1.PersistentVolumeClaim {
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "data-" + groupName + "-" + index,
+				Namespace: namespaceStr,
+				Labels: map[string]string{
+					utils.RayClusterLabelKey:          instanceName,
+					utils.RayNodeGroupLabelKey:        groupName,
+					utils.RayVolumeClaimIndexKey:      index,
+					utils.KubernetesCreatedByLabelKey: utils.ComponentName,
+				},
+			},
+		}
+		if mutate != nil {
+			mutate(pvc)
+		}
+		return pvc
+	}
+	fakeClient := clientFake.NewClientBuilder().WithRuntimeObjects(
+		// A claim retained from a deleted Pod can be used again.
+		claim("0", groupNameStr, nil),
+		// A claim owned by a deleted Pod is about to be garbage collected.
+		claim("1", groupNameStr, func(pvc *corev1.PersistentVolumeClaim) {
+			pvc.OwnerReferences = []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: "deleted-pod", UID: "deleted-pod-uid"}}
+		}),
+		// A claim that is being deleted.
+		claim("3", groupNameStr, func(pvc *corev1.PersistentVolumeClaim) {
+			pvc.Finalizers = []string{"kubernetes.io/pvc-protection"}
+			pvc.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		}),
+		// The claims of other groups don't matter.
+		claim("4", "other-group", func(pvc *corev1.PersistentVolumeClaim) {
+			pvc.OwnerReferences = []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: "other-pod", UID: "other-pod-uid"}}
+		}),
+	).Build()
+	testRayClusterReconciler := &RayClusterReconciler{
+		Client:   fakeClient,
+		Recorder: &record.FakeRecorder{},
+		Scheme:   scheme.Scheme,
+	}
+	pods := []corev1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Name: "worker-2", Labels: map[string]string{utils.RayVolumeClaimIndexKey: "2"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "worker-unlabeled"}},
+	}
+
+	claimIndices, err := testRayClusterReconciler.newVolumeClaimIndices(context.Background(), testRayCluster, groupNameStr, templates, pods)
+	require.NoError(t, err)
+	assert.Equal(t, 0, claimIndices.next())
+	assert.Equal(t, 4, claimIndices.next())
+	assert.Equal(t, 5, claimIndices.next())
+
+	// Groups without volumeClaimTemplates don't use volume claim indices.
+	claimIndices, err = testRayClusterReconciler.newVolumeClaimIndices(context.Background(), testRayCluster, groupNameStr, nil, pods)
+	require.NoError(t, err)
+	assert.Nil(t, claimIndices)
+}
+
+func TestReconcile_DrainWorkersToDelete(t *testing.T) {
+	setupTest(t)
+
+	// This test makes some assumptions about the testRayCluster object.
+	// (1) 1 workerGroup (2) disable autoscaling
+	assert.Len(t, testRayCluster.Spec.WorkerGroupSpecs, 1, "This test assumes only one worker group.")
+	testRayCluster.Spec.EnableInTreeAutoscaling = ptr.To(false)
+	testRayCluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](2)
+	testRayCluster.Spec.WorkerGroupSpecs[0].NumOfHosts = 1
+	testRayCluster.Spec.WorkerGroupSpecs[0].DrainTimeoutSeconds = ptr.To[int32](60)
+	testRayCluster.Spec.WorkerGroupSpecs[0].ScaleStrategy.WorkersToDelete = []string{"worker-0"}
+
+	headService, err := common.BuildServiceForHeadPod(context.Background(), *testRayCluster, nil, nil)
+	require.NoError(t, err, "Failed to build head service.")
+
+	workerPod := func(name string, podIP string, annotations map[string]string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespaceStr,
+				Labels: map[string]string{
+					utils.RayNodeLabelKey:      "yes",
+					utils.RayClusterLabelKey:   instanceName,
+					utils.RayNodeTypeLabelKey:  string(rayv1.WorkerNode),
+					utils.RayNodeGroupLabelKey: groupNameStr,
+				},
+				Annotations: annotations,
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "ray-worker", Image: "rayproject/ray:2.9.0"}},
+			},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				PodIP: podIP,
+				Conditions: []corev1.PodCondition{
+					{
+						Type:   corev1.PodReady,
+						Status: corev1.ConditionTrue,
+					},
+				},
+			},
+		}
+	}
+	aliveNode := utils.RayNodeInfo{NodeID: "node-0", NodeIP: "10.0.0.10", State: utils.RayNodeStateAlive}
+
+	tests := []struct {
+		drainNodeErr          error
+		drainStartTime        string
+		name                  string
+		nodes                 []utils.RayNodeInfo
+		expectedNumPods       int
+		expectedDraining      bool
+		expectedDrainedNodeID string
+	}{
+		{
+			name:                  "Drain the Ray node before deleting the Pod",
+			nodes:                 []utils.RayNodeInfo{aliveNode},
+			expectedNumPods:       3,
+			expectedDraining:      true,
+			expectedDrainedNodeID: "node-0",
+		},
+		{
+			name:             "Keep the Pod while the Ray node is being drained",
+			nodes:            []utils.RayNodeInfo{aliveNode},
+			drainStartTime:   time.Now().Add(-10 * time.Second).UTC().Format(time.RFC3339),
+			expectedNumPods:  3,
+			expectedDraining: true,
+		},
+		{
+			name:            "Delete the Pod after the Ray node exits",
+			nodes:           []utils.RayNodeInfo{{NodeID: "node-0", NodeIP: "10.0.0.10", State: "DEAD"}},
+			drainStartTime:  time.Now().Add(-10 * time.Second).UTC().Format(time.RFC3339),
+			expectedNumPods: 2,
+		},
+		{
+			name:            "Delete the Pod after the drain timeout",
+			nodes:           []utils.RayNodeInfo{aliveNode},
+			drainStartTime:  time.Now().Add(-2 * time.Minute).UTC().Format(time.RFC3339),
+			expectedNumPods: 2,
+		},
+		{
+			name:                  "Delete the Pod if the drain request is rejected",
+			nodes:                 []utils.RayNodeInfo{aliveNode},
+			drainNodeErr:          errors.New("DrainNode rejected"),
+			expectedNumPods:       2,
+			expectedDrainedNodeID: "node-0",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var annotations map[string]string
+			if tc.drainStartTime != "" {
+				annotations = map[string]string{utils.RayWorkerDrainStartTimeKey: tc.drainStartTime}
+			}
+			runtimeObjects := []runtime.Object{
+				testPods[0], headService,
+				workerPod("worker-0", "10.0.0.10", annotations),
+				workerPod("worker-1", "10.0.0.11", nil),
+				workerPod("worker-2", "10.0.0.12", nil),
+			}
+			fakeClient := clientFake.NewClientBuilder().WithRuntimeObjects(runtimeObjects...).Build()
+			ctx := context.Background()
+
+			var drainedNodeID string
+			fakeDashboardClient := &utils.FakeRayDashboardClient{}
+			fakeDashboardClient.SetNodes(tc.nodes)
+			drainNodeMock := func(_ context.Context, nodeID string) error {
+				drainedNodeID = nodeID
+				return tc.drainNodeErr
+			}
+			fakeDashboardClient.DrainNodeMock.Store(&drainNodeMock)
+
+			testRayClusterReconciler := &RayClusterReconciler{
+				Client:                     fakeClient,
+				Recorder:                   &record.FakeRecorder{},
+				Scheme:                     scheme.Scheme,
+				rayClusterScaleExpectation: expectations.NewRayClusterScaleExpectation(fakeClient),
+				dashboardClientFunc: func() utils.RayDashboardClientInterface {
+					return fakeDashboardClient
+				},
+			}
+
+			err := testRayClusterReconciler.reconcilePods(ctx, testRayCluster)
+			require.NoError(t, err, "Fail to reconcile Pods")
+
+			podList := corev1.PodList{}
+			err = fakeClient.List(ctx, &podList, &client.ListOptions{
+				LabelSelector: workerSelector,
+				Namespace:     namespaceStr,
+			})
+			require.NoError(t, err, "Fail to get pod list after reconcile")
+			// The Pod being drained is neither replaced nor counted towards the desired replicas.
+			assert.Len(t, podList.Items, tc.expectedNumPods)
+			assert.Equal(t, tc.expectedDrainedNodeID, drainedNodeID)
+			if tc.expectedDraining {
+				assert.Equal(t, int32(1), utils.CalculateDrainingReplicas(podList))
+			} else {
+				assert.Equal(t, int32(0), utils.CalculateDrainingReplicas(podList))
+			}
+		})
+	}
+}
+
+func TestReconcileIdleSuspend(t *testing.T) {
+	setupTest(t)
+	features.SetFeatureGateDuringTest(t, features.RayClusterStatusConditions, true)
+
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	testRayCluster.Spec.IdleSuspendPolicy = &rayv1.IdleSuspendPolicy{IdleTimeoutSeconds: 600}
+	testRayCluster.Status.State = rayv1.Ready
+	headService, err := common.BuildServiceForHeadPod(context.Background(), *testRayCluster, nil, nil)
+	require.NoError(t, err, "Failed to build head service.")
+
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(testRayCluster, headService).Build()
+	ctx := context.Background()
+
+	jobStatus := rayv1.JobStatusRunning
+	fakeDashboardClient := &utils.FakeRayDashboardClient{}
+	getJobInfoMock := func(_ context.Context, _ string) (*utils.RayJobInfo, error) {
+		return &utils.RayJobInfo{JobStatus: jobStatus}, nil
+	}
+	fakeDashboardClient.GetJobInfoMock.Store(&getJobInfoMock)
+	fakeRecorder := record.NewFakeRecorder(10)
+	testRayClusterReconciler := &RayClusterReconciler{
+		Client:   fakeClient,
+		Recorder: fakeRecorder,
+		Scheme:   newScheme,
+		dashboardClientFunc: func() utils.RayDashboardClientInterface {
+			return fakeDashboardClient
+		},
+	}
+	cluster := &rayv1.RayCluster{}
+	err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespaceStr, Name: instanceName}, cluster)
+	require.NoError(t, err)
+	cluster.Status.State = rayv1.Ready
+
+	// A running Ray job keeps the RayCluster active.
+	err = testRayClusterReconciler.reconcileIdleSuspend(ctx, cluster)
+	require.NoError(t, err)
+	assert.Nil(t, cluster.Status.IdleSince)
+
+	// Once no job is running, the RayCluster is idle but not suspended before the idle timeout.
+	jobStatus = rayv1.JobStatusSucceeded
+	err = testRayClusterReconciler.reconcileIdleSuspend(ctx, cluster)
+	require.NoError(t, err)
+	require.NotNil(t, cluster.Status.IdleSince)
+	assert.Nil(t, cluster.Spec.Suspend)
+
+	// A running serve application keeps the RayCluster active unless the policy doesn't count it.
+	fakeDashboardClient.SetMultiApplicationStatuses(map[string]*utils.ServeApplicationStatus{
+		"app": {Status: rayv1.ApplicationStatusEnum.RUNNING},
+	})
+	err = testRayClusterReconciler.reconcileIdleSuspend(ctx, cluster)
+	require.NoError(t, err)
+	assert.Nil(t, cluster.Status.IdleSince)
+
+	cluster.Spec.IdleSuspendPolicy.CountServeApplications = ptr.To(false)
+	err = testRayClusterReconciler.reconcileIdleSuspend(ctx, cluster)
+	require.NoError(t, err)
+	require.NotNil(t, cluster.Status.IdleSince)
+
+	// After the idle timeout, the RayCluster is suspended.
+	cluster.Status.IdleSince = ptr.To(metav1.NewTime(time.Now().Add(-time.Hour)))
+	err = testRayClusterReconciler.reconcileIdleSuspend(ctx, cluster)
+	require.NoError(t, err)
+	assert.Nil(t, cluster.Status.IdleSince)
+	assert.True(t, meta.IsStatusConditionTrue(cluster.Status.Conditions, string(rayv1.RayClusterIdleSuspended)))
+	require.Len(t, fakeRecorder.Events, 1)
+	assert.Contains(t, <-fakeRecorder.Events, string(utils.SuspendedIdleRayCluster))
+
+	updatedCluster := &rayv1.RayCluster{}
+	err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespaceStr, Name: instanceName}, updatedCluster)
+	require.NoError(t, err)
+	assert.Equal(t, ptr.To(true), updatedCluster.Spec.Suspend)
+}
+
+func TestReconcileSuspendSchedule(t *testing.T) {
+	setupTest(t)
+
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	testRayCluster.Spec.SuspendSchedule = &rayv1.SuspendSchedule{
+		Suspend:  "0 20 * * 1-5",
+		Resume:   "0 7 * * 1-5",
+		TimeZone: ptr.To("Europe/Paris"),
+	}
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(testRayCluster).Build()
+	ctx := context.Background()
+	fakeRecorder := record.NewFakeRecorder(10)
+	testRayClusterReconciler := &RayClusterReconciler{
+		Client:   fakeClient,
+		Recorder: fakeRecorder,
+		Scheme:   newScheme,
+	}
+	cluster := &rayv1.RayCluster{}
+	err := fakeClient.Get(ctx, types.NamespacedName{Namespace: namespaceStr, Name: instanceName}, cluster)
+	require.NoError(t, err)
+
+	// The next action is recorded without changing the RayCluster.
+	err = testRayClusterReconciler.reconcileSuspendSchedule(ctx, cluster)
+	require.NoError(t, err)
+	require.NotNil(t, cluster.Status.NextScheduledAction)
+	assert.True(t, cluster.Status.NextScheduledAction.Time.After(time.Now()))
+	assert.Nil(t, cluster.Spec.Suspend)
+	assert.Empty(t, fakeRecorder.Events)
+
+	// A due suspend action suspends the RayCluster and the following action is recorded.
+	cluster.Status.NextScheduledAction = &rayv1.ScheduledAction{
+		Action: rayv1.ScheduledSuspend,
+		Time:   metav1.NewTime(time.Now().Add(-time.Minute)),
+	}
+	err = testRayClusterReconciler.reconcileSuspendSchedule(ctx, cluster)
+	require.NoError(t, err)
+	require.NotNil(t, cluster.Status.NextScheduledAction)
+	assert.True(t, cluster.Status.NextScheduledAction.Time.After(time.Now()))
+	require.Len(t, fakeRecorder.Events, 1)
+	assert.Contains(t, <-fakeRecorder.Events, string(utils.ScheduledSuspendRayCluster))
+
+	updatedCluster := &rayv1.RayCluster{}
+	err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespaceStr, Name: instanceName}, updatedCluster)
+	require.NoError(t, err)
+	assert.Equal(t, ptr.To(true), updatedCluster.Spec.Suspend)
+
+	// A user can resume the RayCluster before the next scheduled action.
+	cluster.Spec.Suspend = ptr.To(false)
+	err = testRayClusterReconciler.reconcileSuspendSchedule(ctx, cluster)
+	require.NoError(t, err)
+	assert.Equal(t, ptr.To(false), cluster.Spec.Suspend)
+	assert.Empty(t, fakeRecorder.Events)
+
+	// A due resume action resumes the RayCluster.
+	cluster.Spec.Suspend = ptr.To(true)
+	cluster.Status.NextScheduledAction = &rayv1.ScheduledAction{
+		Action: rayv1.ScheduledResume,
+		Time:   metav1.NewTime(time.Now().Add(-time.Minute)),
+	}
+	err = testRayClusterReconciler.reconcileSuspendSchedule(ctx, cluster)
+	require.NoError(t, err)
+	require.Len(t, fakeRecorder.Events, 1)
+	assert.Contains(t, <-fakeRecorder.Events, string(utils.ScheduledResumeRayCluster))
+	err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespaceStr, Name: instanceName}, updatedCluster)
+	require.NoError(t, err)
+	assert.Equal(t, ptr.To(false), updatedCluster.Spec.Suspend)
+
+	// After downtime, the latest due action is applied instead of the stale one recorded in the status.
+	cluster.Spec.SuspendSchedule = &rayv1.SuspendSchedule{Suspend: "0 0 1 1 *", Resume: "* * * * *"}
+	cluster.Spec.Suspend = ptr.To(true)
+	cluster.Status.NextScheduledAction = &rayv1.ScheduledAction{
+		Action: rayv1.ScheduledSuspend,
+		Time:   metav1.NewTime(time.Now().Add(-48 * time.Hour).Truncate(time.Minute)),
+	}
+	err = testRayClusterReconciler.reconcileSuspendSchedule(ctx, cluster)
+	require.NoError(t, err)
+	require.Len(t, fakeRecorder.Events, 1)
+	assert.Contains(t, <-fakeRecorder.Events, string(utils.ScheduledResumeRayCluster))
+	assert.Equal(t, ptr.To(false), cluster.Spec.Suspend)
+
+	// The next action is cleared when the schedule is removed.
+	cluster.Spec.SuspendSchedule = nil
+	err = testRayClusterReconciler.reconcileSuspendSchedule(ctx, cluster)
+	require.NoError(t, err)
+	assert.Nil(t, cluster.Status.NextScheduledAction)
+}
+
+func TestCreateWorkerPodWithDNSCertificateSANs(t *testing.T) {
+	setupTest(t)
+
+	testRayCluster.Spec.SecurityOptions = &rayv1.SecurityOptions{
+		MTLS:            ptr.To(true),
+		CertificateSANs: ptr.To(rayv1.DNSCertificateSANs),
+	}
+	worker := testRayCluster.Spec.WorkerGroupSpecs[0]
+
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).Build()
+	ctx := context.Background()
+	testRayClusterReconciler := &RayClusterReconciler{
+		Client:                     fakeClient,
+		Recorder:                   &record.FakeRecorder{},
+		Scheme:                     newScheme,
+		rayClusterScaleExpectation: expectations.NewRayClusterScaleExpectation(fakeClient),
+	}
+
+	// The headless worker service is created even though the worker group is not multi-host.
+	err := testRayClusterReconciler.reconcileHeadlessService(ctx, testRayCluster)
+	require.NoError(t, err)
+	headlessSvcName := utils.GenerateHeadlessServiceName(instanceName)
+	err = fakeClient.Get(ctx, types.NamespacedName{Namespace: namespaceStr, Name: headlessSvcName}, &corev1.Service{})
+	require.NoError(t, err)
+
+	err = testRayClusterReconciler.createWorkerPod(ctx, *testRayCluster, worker, nil, nil)
+	require.NoError(t, err)
+
+	podList := corev1.PodList{}
+	err = fakeClient.List(ctx, &podList, client.InNamespace(namespaceStr), client.MatchingLabels{utils.RayNodeGroupLabelKey: groupNameStr})
+	require.NoError(t, err)
+	require.Len(t, podList.Items, 1)
+	pod := podList.Items[0]
+
+	// The worker Pod is published under the headless worker service and advertises its DNS name.
+	assert.Equal(t, headlessSvcName, pod.Spec.Subdomain)
+	assert.Equal(t, pod.Name, pod.Spec.Hostname)
+	rayContainer := pod.Spec.Containers[utils.RayContainerIndex]
+	assert.True(t, utils.EnvVarExists("MY_POD_NAME", rayContainer.Env))
+	expectedAddress := "--node-ip-address=$(MY_POD_NAME)." + headlessSvcName + "." + namespaceStr + ".svc." + utils.GetClusterDomainName()
+	assert.Contains(t, strings.Join(append(rayContainer.Command, rayContainer.Args...), " "), expectedAddress)
+
+	// The rayStartParams of the worker group are not modified.
+	_, ok := testRayCluster.Spec.WorkerGroupSpecs[0].RayStartParams["node-ip-address"]
+	assert.False(t, ok)
+}
+
+func TestMTLSWithTrustBundle(t *testing.T) {
+	setupTest(t)
+
+	testRayCluster.Spec.SecurityOptions = &rayv1.SecurityOptions{MTLS: ptr.To(true)}
+	trustBundle := &corev1.ConfigMapKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "corporate-bundle"},
+		Key:                  "bundle.pem",
+	}
+
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	// The certificate Secrets of an external issuer don't need a ca.crt when a trust bundle is used.
+	runtimeObjects := []runtime.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "ray-head-secret-" + instanceName, Namespace: namespaceStr},
+			Data:       map[string][]byte{"tls.crt": []byte("cert"), "tls.key": []byte("key")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "ray-worker-secret-" + instanceName, Namespace: namespaceStr},
+			Data:       map[string][]byte{"tls.crt": []byte("cert"), "tls.key": []byte("key")},
+		},
+	}
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(runtimeObjects...).Build()
+	ctx := context.Background()
+	testRayClusterReconciler := &RayClusterReconciler{
+		Client:   fakeClient,
+		Recorder: &record.FakeRecorder{},
+		Scheme:   newScheme,
+		options: RayClusterReconcilerOptions{
+			MTLSCertificateIssuer: &rayv1.CertificateIssuer{Name: "corporate-ca", Kind: "ClusterIssuer", TrustBundle: trustBundle},
+		},
+	}
+
+	err := testRayClusterReconciler.checkMTLSSecretsReady(ctx, testRayCluster)
+	require.ErrorContains(t, err, "trust bundle configmap corporate-bundle not found")
+
+	err = fakeClient.Create(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "corporate-bundle", Namespace: namespaceStr},
+		Data:       map[string]string{"bundle.pem": "ca"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, testRayClusterReconciler.checkMTLSSecretsReady(ctx, testRayCluster))
+
+	// The Ray Pods read ca.crt from the trust bundle, and the certificate and key from the Secret.
+	pod := testRayClusterReconciler.buildWorkerPod(ctx, *testRayCluster, testRayCluster.Spec.WorkerGroupSpecs[0])
+	var tlsVolume *corev1.Volume
+	for i := range pod.Spec.Volumes {
+		if pod.Spec.Volumes[i].Name == "ray-tls-vol" {
+			tlsVolume = &pod.Spec.Volumes[i]
+		}
+	}
+	require.NotNil(t, tlsVolume)
+	require.NotNil(t, tlsVolume.Projected)
+	require.Len(t, tlsVolume.Projected.Sources, 2)
+	assert.Equal(t, "ray-worker-secret-"+instanceName, tlsVolume.Projected.Sources[0].Secret.Name)
+	assert.Equal(t, "corporate-bundle", tlsVolume.Projected.Sources[1].ConfigMap.Name)
+	assert.Equal(t, []corev1.KeyToPath{{Key: "bundle.pem", Path: "ca.crt"}}, tlsVolume.Projected.Sources[1].ConfigMap.Items)
+}
+
+func TestReconcilePodsAuthenticationModeChange(t *testing.T) {
+	setupTest(t)
+
+	testRayCluster.Spec.SecurityOptions = &rayv1.SecurityOptions{AuthProxy: ptr.To(true)}
+
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+	_ = configv1.Install(newScheme)
+
+	// The head Pod was created while the cluster used the integrated OAuth server.
+	headPod := testPods[0].(*corev1.Pod).DeepCopy()
+	headPod.Annotations = map[string]string{utils.RayAuthenticationModeAnnotationKey: string(utils.ModeIntegratedOAuth)}
+	authentication := &configv1.Authentication{
+		ObjectMeta: metav1.ObjectMeta{Name: utils.ClusterAuthenticationName},
+		Spec:       configv1.AuthenticationSpec{Type: configv1.AuthenticationTypeIntegratedOAuth},
+	}
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(headPod, authentication).Build()
+	ctx := context.Background()
+	testRayClusterReconciler := &RayClusterReconciler{
+		Client:                     fakeClient,
+		Recorder:                   &record.FakeRecorder{},
+		Scheme:                     newScheme,
+		rayClusterScaleExpectation: expectations.NewRayClusterScaleExpectation(fakeClient),
+		options:                    RayClusterReconcilerOptions{IsOpenShift: true},
+	}
+	headPodExists := func() bool {
+		return fakeClient.Get(ctx, client.ObjectKeyFromObject(headPod), &corev1.Pod{}) == nil
+	}
+
+	// The head Pod is kept while the authentication mode doesn't change.
+	require.NoError(t, testRayClusterReconciler.reconcilePods(ctx, testRayCluster))
+	assert.True(t, headPodExists())
+
+	// The head Pod is recreated with the sidecar of the new mode when the cluster switches to OIDC.
+	authentication.Spec.Type = configv1.AuthenticationTypeOIDC
+	require.NoError(t, fakeClient.Update(ctx, authentication))
+	err := testRayClusterReconciler.reconcilePods(ctx, testRayCluster)
+	require.ErrorContains(t, err, "authentication mode of the cluster changed from IntegratedOAuth to OIDC")
+	assert.False(t, headPodExists())
+
+	// The new head Pod records the authentication mode of its sidecar.
+	pod := testRayClusterReconciler.buildHeadPod(ctx, *testRayCluster)
+	assert.Equal(t, string(utils.ModeOIDC), pod.Annotations[utils.RayAuthenticationModeAnnotationKey])
+}
+
+func TestReconcilePodsAuthProxyConfigChange(t *testing.T) {
+	setupTest(t)
+
+	testRayCluster.Spec.SecurityOptions = &rayv1.SecurityOptions{AuthProxy: ptr.To(true)}
+
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+	ctx := context.Background()
+	testRayClusterReconciler := &RayClusterReconciler{
+		Recorder: &record.FakeRecorder{},
+		Scheme:   newScheme,
+	}
+
+	// The head Pod records the hash of the sidecar it was created with.
+	pod := testRayClusterReconciler.buildHeadPod(ctx, *testRayCluster)
+	hash := pod.Annotations[utils.RayAuthProxyHashAnnotationKey]
+	require.NotEmpty(t, hash)
+	sidecar := pod.Spec.Containers[len(pod.Spec.Containers)-1]
+	assert.Equal(t, oidcProxyContainerImage, sidecar.Image)
+
+	headPod := testPods[0].(*corev1.Pod).DeepCopy()
+	headPod.Annotations = map[string]string{
+		utils.RayAuthenticationModeAnnotationKey: string(utils.ModeIntegratedOAuth),
+		utils.RayAuthProxyHashAnnotationKey:      hash,
+	}
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(headPod).Build()
+	testRayClusterReconciler.Client = fakeClient
+	testRayClusterReconciler.rayClusterScaleExpectation = expectations.NewRayClusterScaleExpectation(fakeClient)
+	headPodExists := func() bool {
+		return fakeClient.Get(ctx, client.ObjectKeyFromObject(headPod), &corev1.Pod{}) == nil
+	}
+
+	// The head Pod is kept while the auth proxy configuration doesn't change.
+	require.NoError(t, testRayClusterReconciler.reconcilePods(ctx, testRayCluster))
+	assert.True(t, headPodExists())
+
+	// The head Pod is recreated when the dashboard access policy changes, since the proxy only loads its config at startup.
+	testRayCluster.Spec.SecurityOptions.DashboardAccessPolicy = &rayv1.DashboardAccessPolicy{Users: []string{"alice"}}
+	err := testRayClusterReconciler.reconcilePods(ctx, testRayCluster)
+	require.ErrorContains(t, err, "auth proxy configuration changed")
+	assert.False(t, headPodExists())
+	policyHash := testRayClusterReconciler.buildHeadPod(ctx, *testRayCluster).Annotations[utils.RayAuthProxyHashAnnotationKey]
+	assert.NotEqual(t, hash, policyHash)
+	hash = policyHash
+	headPod.Annotations[utils.RayAuthProxyHashAnnotationKey] = hash
+	headPod.ResourceVersion = ""
+	require.NoError(t, fakeClient.Create(ctx, headPod))
+	testRayClusterReconciler.rayClusterScaleExpectation = expectations.NewRayClusterScaleExpectation(fakeClient)
+
+	// The head Pod is recreated when the operator is configured with a mirrored image.
+	testRayClusterReconciler.options.AuthProxy = &configapi.AuthProxyConfig{
+		KubeRBACProxyImage: "mirror.example.com/kube-rbac-proxy:v0.18",
+		ImagePullPolicy:    corev1.PullAlways,
+		ExtraArgs:          []string{"--v=2"},
+	}
+	err = testRayClusterReconciler.reconcilePods(ctx, testRayCluster)
+	require.ErrorContains(t, err, "auth proxy configuration changed")
+	assert.False(t, headPodExists())
+
+	// The new head Pod gets the configured sidecar.
+	pod = testRayClusterReconciler.buildHeadPod(ctx, *testRayCluster)
+	assert.NotEqual(t, hash, pod.Annotations[utils.RayAuthProxyHashAnnotationKey])
+	sidecar = pod.Spec.Containers[len(pod.Spec.Containers)-1]
+	assert.Equal(t, "mirror.example.com/kube-rbac-proxy:v0.18", sidecar.Image)
+	assert.Equal(t, corev1.PullAlways, sidecar.ImagePullPolicy)
+	assert.Equal(t, "--v=2", sidecar.Args[len(sidecar.Args)-1])
+}
+
+func TestBuildHeadPodAuthProxyListeners(t *testing.T) {
+	setupTest(t)
+
+	testRayCluster.Spec.SecurityOptions = &rayv1.SecurityOptions{AuthProxy: ptr.To(true)}
+	testRayClusterReconciler := &RayClusterReconciler{
+		Recorder: &record.FakeRecorder{},
+		Scheme:   scheme.Scheme,
+	}
+	ctx := context.Background()
+
+	pod := testRayClusterReconciler.buildHeadPod(ctx, *testRayCluster)
+	hash := pod.Annotations[utils.RayAuthProxyHashAnnotationKey]
+	containerNames := func(pod corev1.Pod) []string {
+		names := []string{}
+		for _, container := range pod.Spec.Containers {
+			names = append(names, container.Name)
+		}
+		return names
+	}
+	assert.Contains(t, containerNames(pod), oidcProxyContainerName)
+	assert.NotContains(t, containerNames(pod), serveProxyContainerName)
+	assert.NotContains(t, containerNames(pod), clientProxyContainerName)
+
+	// Enabling the listeners adds their sidecars and changes the hash, so the head Pod is recreated.
+	testRayCluster.Spec.SecurityOptions.ServeAuthProxy = ptr.To(true)
+	testRayCluster.Spec.SecurityOptions.ClientAuthProxy = ptr.To(true)
+	pod = testRayClusterReconciler.buildHeadPod(ctx, *testRayCluster)
+	assert.Contains(t, containerNames(pod), serveProxyContainerName)
+	assert.Contains(t, containerNames(pod), clientProxyContainerName)
+	assert.NotEqual(t, hash, pod.Annotations[utils.RayAuthProxyHashAnnotationKey])
+}
+
+func TestReconcilePodsWithSchedulerNotEnabled(t *testing.T) {
+	setupTest(t)
+
+	// The RayCluster selects Volcano, but the operator has no batch scheduler configured.
+	testRayCluster.Labels = map[string]string{utils.RaySchedulerName: "volcano"}
+	schedulerMgr, err := batchscheduler.NewSchedulerManager(context.Background(), configapi.Configuration{}, nil)
+	require.NoError(t, err)
+
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).Build()
+	fakeRecorder := record.NewFakeRecorder(100)
+	testRayClusterReconciler := &RayClusterReconciler{
+		Client:                     fakeClient,
+		Recorder:                   fakeRecorder,
+		Scheme:                     newScheme,
+		BatchSchedulerMgr:          schedulerMgr,
+		rayClusterScaleExpectation: expectations.NewRayClusterScaleExpectation(fakeClient),
+	}
+	ctx := context.Background()
+
+	// The Pods are created with the default scheduler, and the unknown scheduler is reported.
+	require.NoError(t, testRayClusterReconciler.reconcilePods(ctx, testRayCluster))
+	podList := corev1.PodList{}
+	require.NoError(t, fakeClient.List(ctx, &podList, client.InNamespace(namespaceStr)))
+	require.NotEmpty(t, podList.Items)
+	for _, pod := range podList.Items {
+		assert.Empty(t, pod.Spec.SchedulerName)
+	}
+	// Every Pod created with the default scheduler is reported once.
+	notEnabledEvents := func() int {
+		count := 0
+		for len(fakeRecorder.Events) > 0 {
+			if event := <-fakeRecorder.Events; strings.Contains(event, string(utils.BatchSchedulerNotEnabled)) {
+				assert.Contains(t, event, corev1.EventTypeWarning)
+				count++
+			}
+		}
+		return count
+	}
+	assert.Equal(t, len(podList.Items), notEnabledEvents())
+
+	// A RayCluster in a steady state doesn't report the scheduler again.
+	require.NoError(t, testRayClusterReconciler.reconcilePods(ctx, testRayCluster))
+	assert.Zero(t, notEnabledEvents())
+}
+
+func TestGetOwnerRayJob(t *testing.T) {
+	ctx := context.Background()
+	rayJob := &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "rayjob-sample", Namespace: "default", UID: "rayjob-uid"},
+	}
+	rayCluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "rayjob-sample-raycluster", Namespace: "default"},
+	}
+
+	fakeClient := clientFake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(rayJob).Build()
+	r := &RayClusterReconciler{Client: fakeClient, Scheme: scheme.Scheme}
+
+	// A RayCluster that isn't created by a RayJob has no owner RayJob.
+	owner, err := r.getOwnerRayJob(ctx, rayCluster)
+	require.NoError(t, err)
+	assert.Nil(t, owner)
+
+	rayCluster.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(rayJob, rayv1.SchemeGroupVersion.WithKind("RayJob"))}
+	owner, err = r.getOwnerRayJob(ctx, rayCluster)
+	require.NoError(t, err)
+	require.NotNil(t, owner)
+	assert.Equal(t, rayJob.Name, owner.Name)
+
+	// The RayJob may be deleted before the RayCluster.
+	require.NoError(t, fakeClient.Delete(ctx, rayJob))
+	owner, err = r.getOwnerRayJob(ctx, rayCluster)
+	require.NoError(t, err)
+	assert.Nil(t, owner)
+}
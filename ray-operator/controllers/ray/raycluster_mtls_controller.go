@@ -2,7 +2,11 @@ package ray
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -10,14 +14,21 @@ import (
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	configapi "github.com/ray-project/kuberay/ray-operator/apis/config/v1alpha1"
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/common"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
 )
 
@@ -37,6 +48,12 @@ const (
 
 	RayClusterMTLSDefaultRequeueDuration = 30 * time.Second
 	RayClusterMTLSPeriodicCheckDuration  = 1 * time.Minute
+	// RayClusterMTLSCertificateExpiringSoonThreshold is the time left before a certificate expires below which the
+	// CertificateExpiringSoon condition is set. Certificates are normally renewed 15 days before they expire.
+	RayClusterMTLSCertificateExpiringSoonThreshold = 7 * 24 * time.Hour
+	// RayClusterMTLSPodRestartTimeout is how long a Pod recreated to load rotated certificates may take to become
+	// ready before the next Pod with outdated certificates is restarted anyway.
+	RayClusterMTLSPodRestartTimeout = 10 * time.Minute
 )
 
 // toSet converts a slice of strings into a set for order-insensitive comparisons
@@ -99,26 +116,34 @@ func normalizeIPs(podIPs []string) []string {
 
 type RayClusterMTLSController struct {
 	client.Client
-	Scheme *runtime.Scheme
-	Config *configapi.Configuration
+	Scheme     *runtime.Scheme
+	Recorder   record.EventRecorder
+	RESTMapper meta.RESTMapper
+	Config     *configapi.Configuration
 }
 
 // NewRayClusterMTLSController creates a new MTLS controller instance
-func NewRayClusterMTLSController(client client.Client, scheme *runtime.Scheme, config *configapi.Configuration) *RayClusterMTLSController {
+func NewRayClusterMTLSController(client client.Client, scheme *runtime.Scheme, recorder record.EventRecorder, restMapper meta.RESTMapper, config *configapi.Configuration) *RayClusterMTLSController {
 	return &RayClusterMTLSController{
-		Client: client,
-		Scheme: scheme,
-		Config: config,
+		Client:     client,
+		Scheme:     scheme,
+		Recorder:   recorder,
+		RESTMapper: restMapper,
+		Config:     config,
 	}
 }
 
-// RayClusterMTLSController manages CA certificates for MTLS-enabled Ray clusters using cert-manager
+// RayClusterMTLSController manages CA certificates for MTLS-enabled Ray clusters using cert-manager, or its
+// built-in PKI if cert-manager is not installed
 // +kubebuilder:rbac:groups=ray.io,resources=rayclusters,verbs=get;list;watch
 // +kubebuilder:rbac:groups=cert-manager.io,resources=issuers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cert-manager.io,resources=clusterissuers,verbs=get;list;watch
 // +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=cert-manager.io,resources=certificates/status,verbs=get;update;patch
-// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;delete
-// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=ray.io,resources=rayclusters/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 
 // Reconcile handles the reconciliation of CA certificates using cert-manager
 func (r *RayClusterMTLSController) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -135,38 +160,133 @@ func (r *RayClusterMTLSController) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, err
 	}
 
-	// Check if MTLS is enabled via annotation
-	if instance.Annotations == nil || instance.Annotations[utils.EnableSecureTrustedNetworkAnnotationKey] != "true" {
+	// Check if MTLS is enabled via security options or annotation
+	if !utils.IsMTLSEnabled(instance) {
 		logger.Info("MTLS is disabled for RayCluster, skipping reconciliation", "rayCluster", instance.Name)
+		if instance.Status.Certificates != nil ||
+			meta.FindStatusCondition(instance.Status.Conditions, string(rayv1.RayClusterCertificatesReady)) != nil ||
+			meta.FindStatusCondition(instance.Status.Conditions, string(rayv1.RayClusterCertificateExpiringSoon)) != nil {
+			patch := client.MergeFromWithOptions(instance.DeepCopy(), client.MergeFromWithOptimisticLock{})
+			instance.Status.Certificates = nil
+			meta.RemoveStatusCondition(&instance.Status.Conditions, string(rayv1.RayClusterCertificatesReady))
+			meta.RemoveStatusCondition(&instance.Status.Conditions, string(rayv1.RayClusterCertificateExpiringSoon))
+			if err := r.Status().Patch(ctx, instance, patch); err != nil {
+				return ctrl.Result{RequeueAfter: RayClusterMTLSDefaultRequeueDuration}, err
+			}
+		}
 		return ctrl.Result{}, nil
 	}
 
 	logger.Info("MTLS is enabled for RayCluster", "rayCluster", instance.Name)
 
-	// Reconcile self-signed issuer (needed to bootstrap the CA)
-	if err := r.reconcileSelfSignedIssuer(ctx, instance); err != nil {
-		logger.Error(err, "Failed to reconcile self-signed issuer")
-		return ctrl.Result{RequeueAfter: RayClusterMTLSDefaultRequeueDuration}, err
+	// Wait for pod IPs to be available before creating certificates, unless the certificates use DNS SANs
+	useDNSSANs := utils.IsDNSCertificateSANsEnabled(instance)
+	var podIPs []string
+	if !useDNSSANs {
+		var err error
+		if podIPs, err = r.getPodIPs(ctx, instance); err != nil {
+			logger.Error(err, "Failed to get pod IPs")
+			return ctrl.Result{RequeueAfter: RayClusterMTLSDefaultRequeueDuration}, err
+		}
 	}
 
-	// Reconcile RootCA
-	if err := r.reconcileRootCA(ctx, instance); err != nil {
-		logger.Error(err, "Failed to reconcile RootCA")
-		return ctrl.Result{RequeueAfter: RayClusterMTLSDefaultRequeueDuration}, err
+	ready := false
+	var issueErr error
+	if r.isCertManagerInstalled() {
+		ready, issueErr = r.reconcileCertManagerCertificates(ctx, instance, podIPs)
+	} else {
+		// Without cert-manager, the operator issues the certificates itself
+		issueErr = r.reconcileBuiltinPKI(ctx, instance, podIPs)
+		ready = issueErr == nil
+	}
+	if issueErr != nil {
+		logger.Error(issueErr, "Failed to issue certificates")
 	}
 
-	// Reconcile CA issuer (uses the CA secret created by the CA certificate)
-	if err := r.reconcileCAIssuer(ctx, instance); err != nil {
-		logger.Error(err, "Failed to reconcile CA issuer")
+	// Detect rotated certificates and check certificate expiry
+	originalInstance := instance.DeepCopy()
+	if instance.Status.Certificates == nil {
+		instance.Status.Certificates = &rayv1.CertificatesStatus{}
+	}
+	// Only report the failure when it changes, since the reconciliation is retried until it succeeds.
+	if changed := setCertificatesReadyCondition(instance, ready, issueErr); changed && issueErr != nil {
+		r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToIssueCertificates),
+			"Failed to issue the mTLS certificates of RayCluster %s/%s, %v", instance.Namespace, instance.Name, issueErr)
+	}
+	rotated := false
+	if ready {
+		var err error
+		if rotated, err = r.detectCertificateRotation(ctx, instance); err != nil {
+			logger.Error(err, "Failed to detect certificate rotation")
+			return ctrl.Result{RequeueAfter: RayClusterMTLSDefaultRequeueDuration}, err
+		}
+	}
+	if err := r.checkCertificateExpiry(ctx, instance); err != nil {
+		logger.Error(err, "Failed to check certificate expiry")
 		return ctrl.Result{RequeueAfter: RayClusterMTLSDefaultRequeueDuration}, err
 	}
+	if !reflect.DeepEqual(originalInstance.Status.Certificates, instance.Status.Certificates) ||
+		!reflect.DeepEqual(originalInstance.Status.Conditions, instance.Status.Conditions) {
+		// The RayCluster controller also updates the status, so don't overwrite the conditions it set in the meantime.
+		if err := r.Status().Patch(ctx, instance, client.MergeFromWithOptions(originalInstance, client.MergeFromWithOptimisticLock{})); err != nil {
+			logger.Error(err, "Failed to update the certificates status")
+			return ctrl.Result{RequeueAfter: RayClusterMTLSDefaultRequeueDuration}, err
+		}
+	}
+	if !ready {
+		logger.Info("One or more certificates are not ready, requeuing")
+		return ctrl.Result{RequeueAfter: RayClusterMTLSDefaultRequeueDuration}, issueErr
+	}
+	if rotated {
+		r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.CertificatesRotated),
+			"The mTLS certificates of RayCluster %s/%s were rotated, restarting its Pods to load them", instance.Namespace, instance.Name)
+	}
 
-	// Wait for pod IPs to be available before creating certificates
-	podIPs, err := r.getPodIPs(ctx, instance)
+	// Restart the Ray Pods that still use the certificates from before the last rotation
+	restarting, err := r.restartPodsWithOutdatedCertificates(ctx, instance)
 	if err != nil {
-		logger.Error(err, "Failed to get pod IPs")
+		logger.Error(err, "Failed to restart Pods with outdated certificates")
 		return ctrl.Result{RequeueAfter: RayClusterMTLSDefaultRequeueDuration}, err
 	}
+	if restarting {
+		return ctrl.Result{RequeueAfter: DefaultRequeueDuration}, nil
+	}
+
+	logger.Info("All mTLS certificates are ready", "rayCluster", instance.Name, "podIPs", podIPs)
+	return ctrl.Result{RequeueAfter: RayClusterMTLSPeriodicCheckDuration}, nil
+}
+
+// reconcileCertManagerCertificates creates or updates the issuers and the head and worker certificates with
+// cert-manager, and returns whether the certificates are ready
+func (r *RayClusterMTLSController) reconcileCertManagerCertificates(ctx context.Context, instance *rayv1.RayCluster, podIPs []string) (bool, error) {
+	logger := log.FromContext(ctx)
+	useDNSSANs := utils.IsDNSCertificateSANsEnabled(instance)
+
+	if issuer := utils.GetCertificateIssuer(instance, r.defaultCertificateIssuer()); issuer != nil {
+		// Use the external issuer instead of bootstrapping a CA for the RayCluster
+		if err := r.reconcileExternalIssuer(ctx, instance, issuer); err != nil {
+			logger.Error(err, "Failed to reconcile external certificate issuer")
+			return false, err
+		}
+	} else {
+		// Reconcile self-signed issuer (needed to bootstrap the CA)
+		if err := r.reconcileSelfSignedIssuer(ctx, instance); err != nil {
+			logger.Error(err, "Failed to reconcile self-signed issuer")
+			return false, err
+		}
+
+		// Reconcile RootCA
+		if err := r.reconcileRootCA(ctx, instance); err != nil {
+			logger.Error(err, "Failed to reconcile RootCA")
+			return false, err
+		}
+
+		// Reconcile CA issuer (uses the CA secret created by the CA certificate)
+		if err := r.reconcileCAIssuer(ctx, instance); err != nil {
+			logger.Error(err, "Failed to reconcile CA issuer")
+			return false, err
+		}
+	}
 
 	// Check if certificates need to be created or updated with new pod IPs
 	headCertName := fmt.Sprintf("%s-%s", rayHeadCertName, instance.Name)
@@ -180,68 +300,220 @@ func (r *RayClusterMTLSController) Reconcile(ctx context.Context, req ctrl.Reque
 	if !headCertExists {
 		if err := r.createRayHeadCertificate(ctx, instance, podIPs); err != nil {
 			logger.Error(err, "Failed to create Ray head certificate")
-			return ctrl.Result{RequeueAfter: RayClusterMTLSDefaultRequeueDuration}, err
+			return false, err
+		}
+	} else if useDNSSANs {
+		// Update existing certificate if it doesn't list the DNS SANs, e.g. after switching from pod IPs
+		if err := r.updateCertificateWithDNSNames(ctx, instance, headCertName, true); err != nil {
+			logger.Error(err, "Failed to update head certificate with DNS names")
+			return false, err
 		}
 	} else {
 		// Update existing certificate if pod IPs have changed
 		if err := r.updateCertificateWithPodIPs(ctx, instance, headCertName, podIPs); err != nil {
 			logger.Error(err, "Failed to update head certificate with pod IPs")
-			return ctrl.Result{RequeueAfter: RayClusterMTLSDefaultRequeueDuration}, err
+			return false, err
 		}
 	}
 
 	if !workerCertExists {
 		if err := r.createRayWorkerCertificate(ctx, instance, podIPs); err != nil {
 			logger.Error(err, "Failed to create Ray worker certificate")
-			return ctrl.Result{RequeueAfter: RayClusterMTLSDefaultRequeueDuration}, err
+			return false, err
+		}
+	} else if useDNSSANs {
+		// Update existing certificate if it doesn't list the DNS SANs, e.g. after switching from pod IPs
+		if err := r.updateCertificateWithDNSNames(ctx, instance, workerCertName, false); err != nil {
+			logger.Error(err, "Failed to update worker certificate with DNS names")
+			return false, err
 		}
 	} else {
 		// Update existing certificate if pod IPs have changed
 		if err := r.updateCertificateWithPodIPs(ctx, instance, workerCertName, podIPs); err != nil {
 			logger.Error(err, "Failed to update worker certificate with pod IPs")
-			return ctrl.Result{RequeueAfter: RayClusterMTLSDefaultRequeueDuration}, err
+			return false, err
+		}
+	}
+
+	// Point existing certificates at the current issuer, e.g. after an external issuer is configured
+	for _, certName := range []string{headCertName, workerCertName} {
+		if err := r.updateCertificateIssuerRef(ctx, instance, certName); err != nil {
+			logger.Error(err, "Failed to update certificate issuer", "certificate", certName)
+			return false, err
 		}
 	}
 
 	// Check if all certificates are ready
-	if ready, err := r.checkCertificatesReady(ctx, instance); err != nil {
+	ready, err := r.checkCertificatesReady(ctx, instance)
+	if err != nil {
 		logger.Error(err, "Failed to check certificate readiness")
-		return ctrl.Result{RequeueAfter: RayClusterMTLSDefaultRequeueDuration}, err
-	} else if !ready {
-		logger.Info("One or more certificates are not ready, requeuing")
-		return ctrl.Result{RequeueAfter: RayClusterMTLSDefaultRequeueDuration}, nil
+		return false, err
 	}
+	return ready, nil
+}
 
-	// Check certificate expiry
-	if err := r.checkCertificateExpiry(ctx, instance); err != nil {
-		logger.Error(err, "Failed to check certificate expiry")
-		return ctrl.Result{RequeueAfter: RayClusterMTLSDefaultRequeueDuration}, err
+// detectCertificateRotation records the time at which the Ray Pods must load new certificates in the status of the
+// RayCluster, and returns whether the certificates were rotated since the last check. The certificates are rotated
+// when the CA bundles of the certificate Secrets change, or when the certificates the Pods may have loaded are
+// renewed. Certificates that are only reissued for new SANs, e.g. when Pod IPs change, are not a rotation because
+// the certificates that the Pods loaded are still valid.
+func (r *RayClusterMTLSController) detectCertificateRotation(ctx context.Context, instance *rayv1.RayCluster) (bool, error) {
+	caHash, notAfter, err := r.getCertificateSecretsState(ctx, instance)
+	if err != nil {
+		return false, err
+	}
+	status := instance.Status.Certificates
+	// The first state is recorded when the Pods are created with the initial certificates, so it is not a rotation.
+	if status.Hash == "" || status.LoadedNotAfter == nil {
+		status.Hash = caHash
+		status.LoadedNotAfter = notAfter.DeepCopy()
+		return false, nil
 	}
 
-	logger.Info("All mTLS certificates are ready", "rayCluster", instance.Name, "podIPs", podIPs)
-	return ctrl.Result{RequeueAfter: RayClusterMTLSPeriodicCheckDuration}, nil
+	renewed := notAfter.After(status.LoadedNotAfter.Time) && time.Now().Add(builtinCertificateRenewBefore).After(status.LoadedNotAfter.Time)
+	if status.Hash == caHash && !renewed {
+		// The Pods created since the last rotation may have loaded any certificate issued since then.
+		if notAfter.Before(status.LoadedNotAfter) {
+			status.LoadedNotAfter = notAfter.DeepCopy()
+		}
+		return false, nil
+	}
+	log.FromContext(ctx).Info("Detected rotated mTLS certificates", "rayCluster", instance.Name,
+		"caChanged", status.Hash != caHash, "loadedNotAfter", status.LoadedNotAfter.Time, "notAfter", notAfter.Time)
+	status.Hash = caHash
+	status.LoadedNotAfter = notAfter.DeepCopy()
+	status.LastRotationTime = ptr.To(metav1.Now())
+	return true, nil
 }
 
-func (r *RayClusterMTLSController) cleanupMTLSResources(ctx context.Context, namespace, clusterName string) (ctrl.Result, error) {
+// getCertificateSecretsState returns a hash of the CA bundles of the head and worker certificate Secrets, and the
+// earliest expiration time of their certificates.
+func (r *RayClusterMTLSController) getCertificateSecretsState(ctx context.Context, instance *rayv1.RayCluster) (string, *metav1.Time, error) {
+	hash := sha256.New()
+	var notAfter *metav1.Time
+	for _, secretName := range []string{
+		fmt.Sprintf("%s-%s", rayHeadSecretName, instance.Name),
+		fmt.Sprintf("%s-%s", rayWorkerSecretName, instance.Name),
+	} {
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, client.ObjectKey{Name: secretName, Namespace: instance.Namespace}, secret); err != nil {
+			return "", nil, err
+		}
+		caPEM := secret.Data[cmmeta.TLSCAKey]
+		fmt.Fprintf(hash, "%s:%d\n", secretName, len(caPEM))
+		hash.Write(caPEM)
+
+		cert, _, err := parseKeyPair(secret)
+		if err != nil {
+			return "", nil, err
+		}
+		if notAfter == nil || cert.NotAfter.Before(notAfter.Time) {
+			notAfter = &metav1.Time{Time: cert.NotAfter}
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil)), notAfter, nil
+}
+
+// restartPodsWithOutdatedCertificates deletes the Ray Pods created before the last certificate rotation so that
+// the RayCluster controller recreates them with the rotated certificates. Worker Pods are restarted one at a time,
+// and the head Pod is restarted last, once every worker Pod uses the rotated certificates. A running and ready Pod is
+// only deleted when every worker group has its desired number of Pods and the previously restarted Pods are ready,
+// or failed to become ready within RayClusterMTLSPodRestartTimeout. Outdated Pods that are not ready are deleted
+// right away. It returns whether Pods are still being restarted.
+func (r *RayClusterMTLSController) restartPodsWithOutdatedCertificates(ctx context.Context, instance *rayv1.RayCluster) (bool, error) {
 	logger := log.FromContext(ctx)
-	logger.Info("Cleaning up MTLS resources for deleted RayCluster", "namespace", namespace, "clusterName", clusterName)
+	if instance.Status.Certificates == nil || instance.Status.Certificates.LastRotationTime == nil ||
+		(instance.Spec.Suspend != nil && *instance.Spec.Suspend) {
+		return false, nil
+	}
+	rotationTime := instance.Status.Certificates.LastRotationTime
 
-	// List of resources to clean up
-	var errors []error
+	pods := corev1.PodList{}
+	if err := r.List(ctx, &pods, common.RayClusterAllPodsAssociationOptions(instance).ToListOptions()...); err != nil {
+		return false, err
+	}
+	var outdatedHeadPod *corev1.Pod
+	var outdatedWorkerPods []*corev1.Pod
+	podsSettled := true
+	numPodsPerGroup := map[string]int{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		isHead := pod.Labels[utils.RayNodeTypeLabelKey] == string(rayv1.HeadNode)
+		if !isHead {
+			numPodsPerGroup[pod.Labels[utils.RayNodeGroupLabelKey]]++
+		}
+		if pod.DeletionTimestamp != nil {
+			podsSettled = false
+			continue
+		}
+		if !pod.CreationTimestamp.Before(rotationTime) {
+			// Give the Pods created with the rotated certificates some time to become ready, but don't wait
+			// forever for a Pod that can't start.
+			if !utils.IsRunningAndReady(pod) && time.Since(pod.CreationTimestamp.Time) < RayClusterMTLSPodRestartTimeout {
+				podsSettled = false
+			}
+			continue
+		}
+		if isHead {
+			outdatedHeadPod = pod
+		} else {
+			outdatedWorkerPods = append(outdatedWorkerPods, pod)
+		}
+	}
+	if outdatedHeadPod == nil && len(outdatedWorkerPods) == 0 {
+		return false, nil
+	}
+	// Wait for the previously restarted Pods to be recreated. The desired number of Pods of a worker group already
+	// accounts for the multiple hosts of its replicas.
+	for _, workerGroup := range instance.Spec.WorkerGroupSpecs {
+		if numPodsPerGroup[workerGroup.GroupName] < int(utils.GetWorkerGroupDesiredReplicas(ctx, workerGroup)) {
+			podsSettled = false
+		}
+	}
 
-	// Clean up CA Issuer
-	caIssuerName := fmt.Sprintf("%s-%s", caIssuerName, clusterName)
-	if err := r.deleteIssuer(ctx, namespace, caIssuerName); err != nil {
-		logger.Error(err, "Failed to delete CA issuer", "issuer", caIssuerName)
-		errors = append(errors, err)
+	// Restart the Pods that are not ready first, since they don't serve anything.
+	sort.Slice(outdatedWorkerPods, func(i, j int) bool {
+		iReady, jReady := utils.IsRunningAndReady(outdatedWorkerPods[i]), utils.IsRunningAndReady(outdatedWorkerPods[j])
+		if iReady != jReady {
+			return !iReady
+		}
+		return outdatedWorkerPods[i].Name < outdatedWorkerPods[j].Name
+	})
+	if len(outdatedWorkerPods) > 0 && (podsSettled || !utils.IsRunningAndReady(outdatedWorkerPods[0])) {
+		pod := outdatedWorkerPods[0]
+		logger.Info("Restarting worker Pod with outdated certificates", "pod", pod.Name, "remaining", len(outdatedWorkerPods)-1)
+		if err := r.Delete(ctx, pod); err != nil && !errors.IsNotFound(err) {
+			r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToDeleteWorkerPod),
+				"Failed deleting worker Pod %s/%s with outdated certificates, %v", pod.Namespace, pod.Name, err)
+			return false, err
+		}
+		r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.DeletedWorkerPod),
+			"Deleted worker Pod %s/%s to load the rotated certificates", pod.Namespace, pod.Name)
+		return true, nil
+	}
+	if len(outdatedWorkerPods) > 0 || outdatedHeadPod == nil ||
+		(!podsSettled && utils.IsRunningAndReady(outdatedHeadPod)) {
+		logger.Info("Waiting for the Pods of the RayCluster to be ready before restarting the next Pod with outdated certificates")
+		return true, nil
 	}
 
-	// Clean up SelfSigned Issuer
-	selfSignedIssuerName := fmt.Sprintf("%s-%s", raySelfSignedIssuerName, clusterName)
-	if err := r.deleteIssuer(ctx, namespace, selfSignedIssuerName); err != nil {
-		logger.Error(err, "Failed to delete SelfSigned issuer", "issuer", selfSignedIssuerName)
-		errors = append(errors, err)
+	logger.Info("Restarting head Pod with outdated certificates", "pod", outdatedHeadPod.Name)
+	if err := r.Delete(ctx, outdatedHeadPod); err != nil && !errors.IsNotFound(err) {
+		r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToDeleteHeadPod),
+			"Failed deleting head Pod %s/%s with outdated certificates, %v", outdatedHeadPod.Namespace, outdatedHeadPod.Name, err)
+		return false, err
 	}
+	r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.DeletedHeadPod),
+		"Deleted head Pod %s/%s to load the rotated certificates", outdatedHeadPod.Namespace, outdatedHeadPod.Name)
+	return true, nil
+}
+
+func (r *RayClusterMTLSController) cleanupMTLSResources(ctx context.Context, namespace, clusterName string) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.Info("Cleaning up MTLS resources for deleted RayCluster", "namespace", namespace, "clusterName", clusterName)
+
+	// List of resources to clean up, starting with the CA bootstrapped for the RayCluster
+	errors := r.cleanupBootstrapCA(ctx, namespace, clusterName)
 
 	// Clean up Ray head certificate
 	headCertName := fmt.Sprintf("%s-%s", rayHeadCertName, clusterName)
@@ -257,13 +529,6 @@ func (r *RayClusterMTLSController) cleanupMTLSResources(ctx context.Context, nam
 		errors = append(errors, err)
 	}
 
-	// Clean up CA certificate
-	caCertName := fmt.Sprintf("%s-%s", caCertificateName, clusterName)
-	if err := r.deleteCertificate(ctx, namespace, caCertName); err != nil {
-		logger.Error(err, "Failed to delete CA certificate", "certificate", caCertName)
-		errors = append(errors, err)
-	}
-
 	// Clean up associated secrets (they should be cleaned up automatically by cert-manager, but let's be explicit)
 	headSecretName := fmt.Sprintf("%s-%s", rayHeadSecretName, clusterName)
 	if err := r.deleteSecret(ctx, namespace, headSecretName); err != nil {
@@ -277,13 +542,6 @@ func (r *RayClusterMTLSController) cleanupMTLSResources(ctx context.Context, nam
 		errors = append(errors, err)
 	}
 
-	// Clean up CA secret
-	caSecretNameFull := fmt.Sprintf("%s-%s", caSecretName, clusterName)
-	if err := r.deleteSecret(ctx, namespace, caSecretNameFull); err != nil {
-		logger.Error(err, "Failed to delete CA secret", "secret", caSecretNameFull)
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
 		logger.Info("Some cleanup operations failed, will retry", "errorCount", len(errors))
 		return ctrl.Result{RequeueAfter: RayClusterMTLSDefaultRequeueDuration}, errors[0]
@@ -293,16 +551,56 @@ func (r *RayClusterMTLSController) cleanupMTLSResources(ctx context.Context, nam
 	return ctrl.Result{}, nil
 }
 
-// deleteIssuer deletes an issuer if it exists
-func (r *RayClusterMTLSController) deleteIssuer(ctx context.Context, namespace, name string) error {
+// cleanupBootstrapCA deletes the issuers, certificate, and secret of the CA bootstrapped for a RayCluster
+func (r *RayClusterMTLSController) cleanupBootstrapCA(ctx context.Context, namespace, clusterName string) []error {
+	logger := log.FromContext(ctx)
+	var errors []error
+
+	// Clean up CA Issuer
+	caIssuerName := fmt.Sprintf("%s-%s", caIssuerName, clusterName)
+	if err := r.deleteIssuer(ctx, namespace, caIssuerName, clusterName); err != nil {
+		logger.Error(err, "Failed to delete CA issuer", "issuer", caIssuerName)
+		errors = append(errors, err)
+	}
+
+	// Clean up SelfSigned Issuer
+	selfSignedIssuerName := fmt.Sprintf("%s-%s", raySelfSignedIssuerName, clusterName)
+	if err := r.deleteIssuer(ctx, namespace, selfSignedIssuerName, clusterName); err != nil {
+		logger.Error(err, "Failed to delete SelfSigned issuer", "issuer", selfSignedIssuerName)
+		errors = append(errors, err)
+	}
+
+	// Clean up CA certificate
+	caCertName := fmt.Sprintf("%s-%s", caCertificateName, clusterName)
+	if err := r.deleteCertificate(ctx, namespace, caCertName); err != nil {
+		logger.Error(err, "Failed to delete CA certificate", "certificate", caCertName)
+		errors = append(errors, err)
+	}
+
+	// Clean up CA secret
+	caSecretNameFull := fmt.Sprintf("%s-%s", caSecretName, clusterName)
+	if err := r.deleteSecret(ctx, namespace, caSecretNameFull); err != nil {
+		logger.Error(err, "Failed to delete CA secret", "secret", caSecretNameFull)
+		errors = append(errors, err)
+	}
+
+	return errors
+}
+
+// deleteIssuer deletes an issuer if it exists and was created for the RayCluster, so that an issuer shared with
+// other RayClusters is never deleted
+func (r *RayClusterMTLSController) deleteIssuer(ctx context.Context, namespace, name, clusterName string) error {
 	issuer := &certmanagerv1.Issuer{}
 	err := r.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, issuer)
 	if err != nil {
-		if errors.IsNotFound(err) {
-			return nil // Already deleted
+		if errors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return nil // Already deleted, or cert-manager is not installed
 		}
 		return err
 	}
+	if issuer.Labels["ray.io/cluster-name"] != clusterName {
+		return nil // Not created for this RayCluster
+	}
 	return r.Delete(ctx, issuer)
 }
 
@@ -311,8 +609,8 @@ func (r *RayClusterMTLSController) deleteCertificate(ctx context.Context, namesp
 	cert := &certmanagerv1.Certificate{}
 	err := r.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, cert)
 	if err != nil {
-		if errors.IsNotFound(err) {
-			return nil // Already deleted
+		if errors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return nil // Already deleted, or cert-manager is not installed
 		}
 		return err
 	}
@@ -448,6 +746,189 @@ func (r *RayClusterMTLSController) updateCertificateWithPodIPs(ctx context.Conte
 	return nil
 }
 
+// dnsCertificateNames returns the DNS SANs of the head or worker certificate when the certificates use DNS SANs:
+// the head service FQDN for the head, and a wildcard of the headless worker service for the workers.
+func dnsCertificateNames(instance *rayv1.RayCluster, isHead bool) ([]string, error) {
+	svcName := utils.GenerateHeadlessServiceName(instance.Name)
+	if isHead {
+		var err error
+		if svcName, err = utils.GenerateHeadServiceName(utils.RayClusterCRD, instance.Spec, instance.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	dnsNames := []string{
+		svcName,
+		"localhost",
+		fmt.Sprintf("%s.%s.svc", svcName, instance.Namespace),
+		fmt.Sprintf("%s.%s.svc.%s", svcName, instance.Namespace, utils.GetClusterDomainName()),
+	}
+	if !isHead {
+		dnsNames = append(dnsNames,
+			fmt.Sprintf("*.%s.%s.svc", svcName, instance.Namespace),
+			fmt.Sprintf("*.%s.%s.svc.%s", svcName, instance.Namespace, utils.GetClusterDomainName()),
+		)
+	}
+	return dnsNames, nil
+}
+
+// headCertificateDNSNames returns the DNS SANs of the head certificate
+func headCertificateDNSNames(instance *rayv1.RayCluster) ([]string, error) {
+	if utils.IsDNSCertificateSANsEnabled(instance) {
+		return dnsCertificateNames(instance, true)
+	}
+
+	headSvcName := fmt.Sprintf("%s-head-svc", instance.Name)
+	return []string{
+		headSvcName,
+		"localhost",
+		fmt.Sprintf("%s.%s.svc", headSvcName, instance.Namespace),
+		fmt.Sprintf("%s.%s.svc.cluster.local", headSvcName, instance.Namespace),
+	}, nil
+}
+
+// workerCertificateDNSNames returns the DNS SANs of the worker certificate
+func workerCertificateDNSNames(instance *rayv1.RayCluster) ([]string, error) {
+	if utils.IsDNSCertificateSANsEnabled(instance) {
+		return dnsCertificateNames(instance, false)
+	}
+
+	workerSvcName := fmt.Sprintf("%s-worker-svc", instance.Name)
+	dnsNames := []string{
+		workerSvcName,
+		"localhost",
+		fmt.Sprintf("%s.%s.svc", workerSvcName, instance.Namespace),
+		fmt.Sprintf("%s.%s.svc.cluster.local", workerSvcName, instance.Namespace),
+	}
+
+	// Add DNS names for each worker group
+	for _, workerGroup := range instance.Spec.WorkerGroupSpecs {
+		groupDNSNames := []string{
+			"localhost",
+			fmt.Sprintf("%s-%s", instance.Name, workerGroup.GroupName),
+			fmt.Sprintf("%s-%s.%s.svc", instance.Name, workerGroup.GroupName, instance.Namespace),
+			fmt.Sprintf("%s-%s.%s.svc.cluster.local", instance.Name, workerGroup.GroupName, instance.Namespace),
+		}
+		dnsNames = append(dnsNames, groupDNSNames...)
+	}
+
+	// Add wildcard patterns for dynamic worker services
+	dnsNames = append(dnsNames,
+		"localhost",
+		fmt.Sprintf("*.%s.%s.svc", workerSvcName, instance.Namespace),
+		fmt.Sprintf("*.%s.%s.svc.cluster.local", workerSvcName, instance.Namespace),
+		fmt.Sprintf("*-worker-*.%s.svc", instance.Namespace),
+		fmt.Sprintf("*-worker-*.%s.svc.cluster.local", instance.Namespace),
+	)
+	return dnsNames, nil
+}
+
+// updateCertificateWithDNSNames updates existing certificate with the DNS SANs, without any pod IPs
+func (r *RayClusterMTLSController) updateCertificateWithDNSNames(ctx context.Context, instance *rayv1.RayCluster, certName string, isHead bool) error {
+	logger := log.FromContext(ctx)
+
+	cert := &certmanagerv1.Certificate{}
+	if err := r.Get(ctx, client.ObjectKey{Name: certName, Namespace: instance.Namespace}, cert); err != nil {
+		return err
+	}
+
+	desiredDNS, err := dnsCertificateNames(instance, isHead)
+	if err != nil {
+		return err
+	}
+	desiredIPs := normalizeIPs(nil)
+	if setsEqual(cert.Spec.DNSNames, desiredDNS) && setsEqual(cert.Spec.IPAddresses, desiredIPs) {
+		return nil
+	}
+
+	cert.Spec.DNSNames = desiredDNS
+	cert.Spec.IPAddresses = desiredIPs
+	if err := r.Update(ctx, cert); err != nil {
+		logger.Error(err, "Failed to update certificate SANs")
+		return err
+	}
+	logger.Info("Updated certificate SANs", "certificate", certName, "dnsNames", desiredDNS, "ipAddresses", desiredIPs)
+	return nil
+}
+
+// isCertManagerInstalled checks if the cert-manager CRDs are installed in the cluster
+func (r *RayClusterMTLSController) isCertManagerInstalled() bool {
+	gvk := certmanagerv1.SchemeGroupVersion.WithKind("Certificate")
+	_, err := r.RESTMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	return err == nil
+}
+
+// defaultCertificateIssuer returns the issuer of the mTLS certificates set in the configuration of the operator
+func (r *RayClusterMTLSController) defaultCertificateIssuer() *rayv1.CertificateIssuer {
+	if r.Config == nil {
+		return nil
+	}
+	return r.Config.MTLSCertificateIssuer
+}
+
+// certificateIssuerRef returns the reference to the issuer of the head and worker certificates, i.e. the external
+// issuer if one is configured, else the CA issuer bootstrapped for the RayCluster
+func (r *RayClusterMTLSController) certificateIssuerRef(instance *rayv1.RayCluster) cmmeta.ObjectReference {
+	if issuer := utils.GetCertificateIssuer(instance, r.defaultCertificateIssuer()); issuer != nil {
+		kind, group := utils.GetCertificateIssuerKindAndGroup(issuer)
+		return cmmeta.ObjectReference{Name: issuer.Name, Kind: kind, Group: group}
+	}
+	return cmmeta.ObjectReference{
+		Name:  fmt.Sprintf("%s-%s", caIssuerName, instance.Name),
+		Kind:  "Issuer",
+		Group: "cert-manager.io",
+	}
+}
+
+// reconcileExternalIssuer checks that the external issuer exists, and deletes the CA bootstrapped for the RayCluster
+// before the external issuer was configured
+func (r *RayClusterMTLSController) reconcileExternalIssuer(ctx context.Context, instance *rayv1.RayCluster, issuer *rayv1.CertificateIssuer) error {
+	// Issuers of other groups, e.g. external issuers of cert-manager, are left to cert-manager to check
+	kind, group := utils.GetCertificateIssuerKindAndGroup(issuer)
+	if group == utils.DefaultCertificateIssuerGroup {
+		var obj client.Object = &certmanagerv1.Issuer{}
+		key := client.ObjectKey{Name: issuer.Name, Namespace: instance.Namespace}
+		if kind == "ClusterIssuer" {
+			obj = &certmanagerv1.ClusterIssuer{}
+			key.Namespace = ""
+		}
+		if err := r.Get(ctx, key, obj); err != nil {
+			if errors.IsNotFound(err) {
+				return fmt.Errorf("%s %s not found", kind, issuer.Name)
+			}
+			return err
+		}
+	}
+
+	if cleanupErrors := r.cleanupBootstrapCA(ctx, instance.Namespace, instance.Name); len(cleanupErrors) > 0 {
+		return cleanupErrors[0]
+	}
+	return nil
+}
+
+// updateCertificateIssuerRef updates existing certificate to reference the current issuer
+func (r *RayClusterMTLSController) updateCertificateIssuerRef(ctx context.Context, instance *rayv1.RayCluster, certName string) error {
+	logger := log.FromContext(ctx)
+
+	cert := &certmanagerv1.Certificate{}
+	if err := r.Get(ctx, client.ObjectKey{Name: certName, Namespace: instance.Namespace}, cert); err != nil {
+		return err
+	}
+
+	issuerRef := r.certificateIssuerRef(instance)
+	if cert.Spec.IssuerRef == issuerRef {
+		return nil
+	}
+
+	cert.Spec.IssuerRef = issuerRef
+	if err := r.Update(ctx, cert); err != nil {
+		logger.Error(err, "Failed to update certificate issuer")
+		return err
+	}
+	logger.Info("Updated certificate issuer", "certificate", certName, "issuer", issuerRef.Name, "kind", issuerRef.Kind)
+	return nil
+}
+
 // reconcileRootCA reconciles the RootCA for the RayCluster
 func (r *RayClusterMTLSController) reconcileRootCA(ctx context.Context, instance *rayv1.RayCluster) error {
 	logger := log.FromContext(ctx)
@@ -576,14 +1057,11 @@ func (r *RayClusterMTLSController) createCAIssuer(ctx context.Context, instance
 // createRayHeadCertificate creates a new Ray head service certificate using cert-manager
 func (r *RayClusterMTLSController) createRayHeadCertificate(ctx context.Context, instance *rayv1.RayCluster, podIPs []string) error {
 	logger := log.FromContext(ctx)
-	headSvcName := fmt.Sprintf("%s-head-svc", instance.Name)
 
 	// Build DNS names
-	dnsNames := []string{
-		headSvcName,
-		"localhost",
-		fmt.Sprintf("%s.%s.svc", headSvcName, instance.Namespace),
-		fmt.Sprintf("%s.%s.svc.cluster.local", headSvcName, instance.Namespace),
+	dnsNames, err := headCertificateDNSNames(instance)
+	if err != nil {
+		return err
 	}
 
 	certificate := &certmanagerv1.Certificate{
@@ -612,11 +1090,7 @@ func (r *RayClusterMTLSController) createRayHeadCertificate(ctx context.Context,
 				certmanagerv1.UsageServerAuth,
 				certmanagerv1.UsageClientAuth,
 			},
-			IssuerRef: cmmeta.ObjectReference{
-				Name:  fmt.Sprintf("%s-%s", caIssuerName, instance.Name),
-				Kind:  "Issuer",
-				Group: "cert-manager.io",
-			},
+			IssuerRef: r.certificateIssuerRef(instance),
 		},
 	}
 
@@ -638,36 +1112,12 @@ func (r *RayClusterMTLSController) createRayHeadCertificate(ctx context.Context,
 func (r *RayClusterMTLSController) createRayWorkerCertificate(ctx context.Context, instance *rayv1.RayCluster, podIPs []string) error {
 	logger := log.FromContext(ctx)
 
-	workerSvcName := fmt.Sprintf("%s-worker-svc", instance.Name)
-
-	// Build DNS names including pod IPs
-	dnsNames := []string{
-		workerSvcName,
-		"localhost",
-		fmt.Sprintf("%s.%s.svc", workerSvcName, instance.Namespace),
-		fmt.Sprintf("%s.%s.svc.cluster.local", workerSvcName, instance.Namespace),
-	}
-
-	// Add DNS names for each worker group
-	for _, workerGroup := range instance.Spec.WorkerGroupSpecs {
-		groupDNSNames := []string{
-			"localhost",
-			fmt.Sprintf("%s-%s", instance.Name, workerGroup.GroupName),
-			fmt.Sprintf("%s-%s.%s.svc", instance.Name, workerGroup.GroupName, instance.Namespace),
-			fmt.Sprintf("%s-%s.%s.svc.cluster.local", instance.Name, workerGroup.GroupName, instance.Namespace),
-		}
-		dnsNames = append(dnsNames, groupDNSNames...)
+	// Build DNS names
+	dnsNames, err := workerCertificateDNSNames(instance)
+	if err != nil {
+		return err
 	}
 
-	// Add wildcard patterns for dynamic worker services
-	dnsNames = append(dnsNames,
-		"localhost",
-		fmt.Sprintf("*.%s.%s.svc", workerSvcName, instance.Namespace),
-		fmt.Sprintf("*.%s.%s.svc.cluster.local", workerSvcName, instance.Namespace),
-		fmt.Sprintf("*-worker-*.%s.svc", instance.Namespace),
-		fmt.Sprintf("*-worker-*.%s.svc.cluster.local", instance.Namespace),
-	)
-
 	certificate := &certmanagerv1.Certificate{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      fmt.Sprintf("%s-%s", rayWorkerCertName, instance.Name),
@@ -694,11 +1144,7 @@ func (r *RayClusterMTLSController) createRayWorkerCertificate(ctx context.Contex
 				certmanagerv1.UsageServerAuth,
 				certmanagerv1.UsageClientAuth,
 			},
-			IssuerRef: cmmeta.ObjectReference{
-				Name:  fmt.Sprintf("%s-%s", caIssuerName, instance.Name),
-				Kind:  "Issuer",
-				Group: "cert-manager.io",
-			},
+			IssuerRef: r.certificateIssuerRef(instance),
 		},
 	}
 
@@ -732,39 +1178,131 @@ func (r *RayClusterMTLSController) checkCertificatesReady(ctx context.Context, i
 		return false, err
 	}
 
+	for _, cert := range []*certmanagerv1.Certificate{rayHeadCertificate, rayWorkerCertificate} {
+		if message, failed := certificateIssuingFailure(cert); failed {
+			return false, fmt.Errorf("certificate %s failed to be issued: %s", cert.Name, message)
+		}
+	}
+
 	return r.isCertificateReady(rayHeadCertificate) && r.isCertificateReady(rayWorkerCertificate), nil
 }
 
-// checkCertificateExpiry checks if certificates are close to expiry and logs warnings
+// certificateIssuingFailure returns the message of the failure of the last issuance of a certificate by cert-manager,
+// and whether the issuance failed
+func certificateIssuingFailure(cert *certmanagerv1.Certificate) (string, bool) {
+	for _, condition := range cert.Status.Conditions {
+		if condition.Type == certmanagerv1.CertificateConditionIssuing && condition.Status == cmmeta.ConditionFalse && condition.Reason == "Failed" {
+			return condition.Message, true
+		}
+	}
+	return "", false
+}
+
+// setCertificatesReadyCondition sets the CertificatesReady condition of the RayCluster, and returns whether the
+// condition changed
+func setCertificatesReadyCondition(instance *rayv1.RayCluster, ready bool, issueErr error) bool {
+	condition := metav1.Condition{
+		Type:    string(rayv1.RayClusterCertificatesReady),
+		Status:  metav1.ConditionTrue,
+		Reason:  rayv1.CertificatesIssued,
+		Message: "The mTLS certificates are issued",
+	}
+	if issueErr != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = rayv1.CertificatesIssuanceFailed
+		condition.Message = issueErr.Error()
+	} else if !ready {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = rayv1.CertificatesPending
+		condition.Message = "Waiting for the mTLS certificates to be issued"
+	}
+	return meta.SetStatusCondition(&instance.Status.Conditions, condition)
+}
+
+// checkCertificateExpiry checks if certificates are close to expiry, logs warnings, and reports the time left
+// before the first certificate expires in the status of the RayCluster
 //
 //nolint:unparam // keeping error return for possible future use
 func (r *RayClusterMTLSController) checkCertificateExpiry(ctx context.Context, instance *rayv1.RayCluster) error {
 	logger := log.FromContext(ctx)
+	var notAfter *metav1.Time
 
 	// Check head certificate expiry
-	headCert := &certmanagerv1.Certificate{}
-	if err := r.Get(ctx, client.ObjectKey{Name: fmt.Sprintf("%s-%s", rayHeadCertName, instance.Name), Namespace: instance.Namespace}, headCert); err == nil {
-		if headCert.Status.NotAfter != nil {
-			timeUntilExpiry := time.Until(headCert.Status.NotAfter.Time)
-			if timeUntilExpiry < 24*time.Hour {
-				logger.Info("Head certificate expires soon", "expiry", headCert.Status.NotAfter.Time, "timeUntilExpiry", timeUntilExpiry)
-			}
+	headNotAfter := r.getCertificateNotAfter(ctx, instance.Namespace, fmt.Sprintf("%s-%s", rayHeadCertName, instance.Name),
+		fmt.Sprintf("%s-%s", rayHeadSecretName, instance.Name))
+	if headNotAfter != nil {
+		timeUntilExpiry := time.Until(headNotAfter.Time)
+		if timeUntilExpiry < 24*time.Hour {
+			logger.Info("Head certificate expires soon", "expiry", headNotAfter.Time, "timeUntilExpiry", timeUntilExpiry)
 		}
+		notAfter = headNotAfter
 	}
 
 	// Check worker certificate expiry
-	workerCert := &certmanagerv1.Certificate{}
-	if err := r.Get(ctx, client.ObjectKey{Name: fmt.Sprintf("%s-%s", rayWorkerCertName, instance.Name), Namespace: instance.Namespace}, workerCert); err == nil {
-		if workerCert.Status.NotAfter != nil {
-			timeUntilExpiry := time.Until(workerCert.Status.NotAfter.Time)
-			if timeUntilExpiry < 24*time.Hour {
-				logger.Info("Worker certificate expires soon", "expiry", workerCert.Status.NotAfter.Time, "timeUntilExpiry", timeUntilExpiry)
-			}
+	workerNotAfter := r.getCertificateNotAfter(ctx, instance.Namespace, fmt.Sprintf("%s-%s", rayWorkerCertName, instance.Name),
+		fmt.Sprintf("%s-%s", rayWorkerSecretName, instance.Name))
+	if workerNotAfter != nil {
+		timeUntilExpiry := time.Until(workerNotAfter.Time)
+		if timeUntilExpiry < 24*time.Hour {
+			logger.Info("Worker certificate expires soon", "expiry", workerNotAfter.Time, "timeUntilExpiry", timeUntilExpiry)
+		}
+		if notAfter == nil || workerNotAfter.Before(notAfter) {
+			notAfter = workerNotAfter
+		}
+	}
+
+	if instance.Status.Certificates != nil {
+		instance.Status.Certificates.NotAfter = notAfter.DeepCopy()
+		instance.Status.Certificates.TimeUntilExpiry = nil
+		if notAfter != nil {
+			// Round down to the hour so that the status is not updated on every periodic check.
+			timeUntilExpiry := max(time.Until(notAfter.Time), 0).Truncate(time.Hour)
+			instance.Status.Certificates.TimeUntilExpiry = &metav1.Duration{Duration: timeUntilExpiry}
 		}
 	}
+
+	// Report the certificates that were not renewed in time, e.g. because their issuer is broken
+	if notAfter == nil {
+		meta.RemoveStatusCondition(&instance.Status.Conditions, string(rayv1.RayClusterCertificateExpiringSoon))
+		return nil
+	}
+	condition := metav1.Condition{
+		Type:    string(rayv1.RayClusterCertificateExpiringSoon),
+		Status:  metav1.ConditionFalse,
+		Reason:  rayv1.CertificatesValid,
+		Message: fmt.Sprintf("The mTLS certificates expire at %s", notAfter.UTC().Format(time.RFC3339)),
+	}
+	if time.Until(notAfter.Time) < RayClusterMTLSCertificateExpiringSoonThreshold {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = rayv1.CertificateExpiring
+		if !meta.IsStatusConditionTrue(instance.Status.Conditions, condition.Type) {
+			r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.CertificateExpiringSoon),
+				"The mTLS certificates of RayCluster %s/%s expire at %s", instance.Namespace, instance.Name, notAfter.UTC().Format(time.RFC3339))
+		}
+	}
+	meta.SetStatusCondition(&instance.Status.Conditions, condition)
 	return nil
 }
 
+// getCertificateNotAfter returns the expiry of a certificate from the status of its cert-manager Certificate, or
+// from its Secret if it was issued by the built-in PKI. It returns nil if the certificate is not issued yet.
+func (r *RayClusterMTLSController) getCertificateNotAfter(ctx context.Context, namespace, certName, secretName string) *metav1.Time {
+	cert := &certmanagerv1.Certificate{}
+	if err := r.Get(ctx, client.ObjectKey{Name: certName, Namespace: namespace}, cert); err == nil {
+		return cert.Status.NotAfter
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, client.ObjectKey{Name: secretName, Namespace: namespace}, secret); err != nil {
+		return nil
+	}
+	x509Cert, _, err := parseKeyPair(secret)
+	if err != nil {
+		return nil
+	}
+	return &metav1.Time{Time: x509Cert.NotAfter}
+}
+
 // isCertificateReady checks if the certificate is ready and valid
 func (r *RayClusterMTLSController) isCertificateReady(cert *certmanagerv1.Certificate) bool {
 	for _, condition := range cert.Status.Conditions {
@@ -806,9 +1344,21 @@ func (r *RayClusterMTLSController) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("raycluster-mtls").
 		For(&rayv1.RayCluster{}).
+		// Reconcile the RayCluster when cert-manager renews its certificate Secrets
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(certificateSecretToRayCluster)).
 		Complete(r)
 }
 
+// certificateSecretToRayCluster maps a head or worker certificate Secret to its RayCluster
+func certificateSecretToRayCluster(_ context.Context, obj client.Object) []reconcile.Request {
+	for _, prefix := range []string{rayHeadSecretName + "-", rayWorkerSecretName + "-"} {
+		if clusterName, ok := strings.CutPrefix(obj.GetName(), prefix); ok && clusterName != "" {
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: clusterName}}}
+		}
+	}
+	return nil
+}
+
 func (r *RayClusterMTLSController) createCACertificate(ctx context.Context, instance *rayv1.RayCluster) error {
 	logger := log.FromContext(ctx)
 
@@ -0,0 +1,482 @@
+package ray
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+	"time"
+
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	configapi "github.com/ray-project/kuberay/ray-operator/apis/config/v1alpha1"
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+func newMTLSTestRayCluster() *rayv1.RayCluster {
+	return &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "raycluster-mtls",
+			Namespace: "default",
+		},
+		Spec: rayv1.RayClusterSpec{
+			SecurityOptions: &rayv1.SecurityOptions{MTLS: ptr.To(true)},
+			WorkerGroupSpecs: []rayv1.WorkerGroupSpec{
+				{
+					GroupName:   "small-group",
+					Replicas:    ptr.To[int32](2),
+					MinReplicas: ptr.To[int32](0),
+					MaxReplicas: ptr.To[int32](2),
+					NumOfHosts:  1,
+				},
+			},
+		},
+	}
+}
+
+func newMTLSTestPod(cluster *rayv1.RayCluster, name string, nodeType rayv1.RayNodeType, created time.Time, ready bool) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         cluster.Namespace,
+			CreationTimestamp: metav1.NewTime(created),
+			Labels: map[string]string{
+				utils.RayClusterLabelKey:  cluster.Name,
+				utils.RayNodeTypeLabelKey: string(nodeType),
+			},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	if nodeType == rayv1.WorkerNode {
+		pod.Labels[utils.RayNodeGroupLabelKey] = cluster.Spec.WorkerGroupSpecs[0].GroupName
+	}
+	if ready {
+		pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+	}
+	return pod
+}
+
+func TestCertificateSecretToRayCluster(t *testing.T) {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ray-worker-secret-my-cluster", Namespace: "ns"}}
+	requests := certificateSecretToRayCluster(context.Background(), secret)
+	require.Len(t, requests, 1)
+	assert.Equal(t, types.NamespacedName{Namespace: "ns", Name: "my-cluster"}, requests[0].NamespacedName)
+
+	secret.Name = "ray-ca-secret-my-cluster"
+	assert.Empty(t, certificateSecretToRayCluster(context.Background(), secret))
+}
+
+func TestDetectCertificateRotation(t *testing.T) {
+	cluster := newMTLSTestRayCluster()
+	cluster.Status.Certificates = &rayv1.CertificatesStatus{}
+	newSecret := func(name string, caPEM []byte, notAfter time.Time) *corev1.Secret {
+		certPEM, keyPEM, err := issueCertificate(&x509.Certificate{
+			Subject:   pkix.Name{CommonName: name},
+			NotBefore: time.Now().Add(-time.Hour),
+			NotAfter:  notAfter,
+		}, nil, nil)
+		require.NoError(t, err)
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name + "-" + cluster.Name, Namespace: cluster.Namespace},
+			Data:       map[string][]byte{"tls.crt": certPEM, "tls.key": keyPEM, "ca.crt": caPEM},
+		}
+	}
+	notAfter := time.Now().Add(90 * 24 * time.Hour).Truncate(time.Second)
+	headSecret := newSecret(rayHeadSecretName, []byte("ca"), notAfter)
+	workerSecret := newSecret(rayWorkerSecretName, []byte("ca"), notAfter)
+	fakeClient := clientFake.NewClientBuilder().WithRuntimeObjects(headSecret, workerSecret).Build()
+	controller := &RayClusterMTLSController{Client: fakeClient}
+	ctx := context.Background()
+	updateWorkerSecret := func(caPEM []byte, notAfter time.Time) {
+		require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(workerSecret), workerSecret))
+		workerSecret.Data = newSecret(rayWorkerSecretName, caPEM, notAfter).Data
+		require.NoError(t, fakeClient.Update(ctx, workerSecret))
+	}
+
+	// The first state is recorded without a rotation.
+	rotated, err := controller.detectCertificateRotation(ctx, cluster)
+	require.NoError(t, err)
+	assert.False(t, rotated)
+	hash := cluster.Status.Certificates.Hash
+	assert.NotEmpty(t, hash)
+	assert.True(t, cluster.Status.Certificates.LoadedNotAfter.Time.Equal(notAfter))
+	assert.Nil(t, cluster.Status.Certificates.LastRotationTime)
+
+	// A certificate reissued for new pod IPs is not a rotation, since the certificates of the Pods are still valid.
+	updateWorkerSecret([]byte("ca"), notAfter.Add(time.Hour))
+	rotated, err = controller.detectCertificateRotation(ctx, cluster)
+	require.NoError(t, err)
+	assert.False(t, rotated)
+	assert.Equal(t, hash, cluster.Status.Certificates.Hash)
+	assert.True(t, cluster.Status.Certificates.LoadedNotAfter.Time.Equal(notAfter))
+	assert.Nil(t, cluster.Status.Certificates.LastRotationTime)
+
+	// A certificate renewed because the certificates of the Pods expire soon is a rotation.
+	cluster.Status.Certificates.LoadedNotAfter = ptr.To(metav1.NewTime(time.Now().Add(24 * time.Hour)))
+	rotated, err = controller.detectCertificateRotation(ctx, cluster)
+	require.NoError(t, err)
+	assert.True(t, rotated)
+	assert.True(t, cluster.Status.Certificates.LoadedNotAfter.Time.Equal(notAfter))
+	require.NotNil(t, cluster.Status.Certificates.LastRotationTime)
+
+	// A certificate issued by a new CA is a rotation.
+	cluster.Status.Certificates.LastRotationTime = nil
+	updateWorkerSecret([]byte("new-ca"), notAfter)
+	rotated, err = controller.detectCertificateRotation(ctx, cluster)
+	require.NoError(t, err)
+	assert.True(t, rotated)
+	assert.NotEqual(t, hash, cluster.Status.Certificates.Hash)
+	assert.NotNil(t, cluster.Status.Certificates.LastRotationTime)
+}
+
+func TestRestartPodsWithOutdatedCertificates(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	cluster := newMTLSTestRayCluster()
+	rotationTime := time.Now().Add(-time.Hour)
+	cluster.Status.Certificates = &rayv1.CertificatesStatus{LastRotationTime: ptr.To(metav1.NewTime(rotationTime))}
+	before, after := rotationTime.Add(-time.Hour), rotationTime.Add(time.Minute)
+	headPod := newMTLSTestPod(cluster, "head", rayv1.HeadNode, before, true)
+	workerA := newMTLSTestPod(cluster, "worker-a", rayv1.WorkerNode, before, true)
+	workerB := newMTLSTestPod(cluster, "worker-b", rayv1.WorkerNode, after, true)
+
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(cluster, headPod, workerA, workerB).Build()
+	fakeRecorder := record.NewFakeRecorder(10)
+	controller := &RayClusterMTLSController{Client: fakeClient, Scheme: newScheme, Recorder: fakeRecorder}
+	ctx := context.Background()
+	podExists := func(name string) bool {
+		return fakeClient.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: name}, &corev1.Pod{}) == nil
+	}
+
+	// The outdated worker Pod is restarted before the head Pod.
+	restarting, err := controller.restartPodsWithOutdatedCertificates(ctx, cluster)
+	require.NoError(t, err)
+	assert.True(t, restarting)
+	assert.False(t, podExists(workerA.Name))
+	assert.True(t, podExists(headPod.Name))
+	require.Len(t, fakeRecorder.Events, 1)
+	assert.Contains(t, <-fakeRecorder.Events, string(utils.DeletedWorkerPod))
+
+	// The head Pod is not restarted until the worker Pod is recreated and ready.
+	restarting, err = controller.restartPodsWithOutdatedCertificates(ctx, cluster)
+	require.NoError(t, err)
+	assert.True(t, restarting)
+	assert.True(t, podExists(headPod.Name))
+
+	newWorker := newMTLSTestPod(cluster, "worker-c", rayv1.WorkerNode, time.Now(), false)
+	require.NoError(t, fakeClient.Create(ctx, newWorker))
+	restarting, err = controller.restartPodsWithOutdatedCertificates(ctx, cluster)
+	require.NoError(t, err)
+	assert.True(t, restarting)
+	assert.True(t, podExists(headPod.Name))
+
+	newWorker.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+	require.NoError(t, fakeClient.Status().Update(ctx, newWorker))
+	restarting, err = controller.restartPodsWithOutdatedCertificates(ctx, cluster)
+	require.NoError(t, err)
+	assert.True(t, restarting)
+	assert.False(t, podExists(headPod.Name))
+	require.Len(t, fakeRecorder.Events, 1)
+	assert.Contains(t, <-fakeRecorder.Events, string(utils.DeletedHeadPod))
+
+	// Nothing is restarted once every Pod was created after the rotation.
+	require.NoError(t, fakeClient.Create(ctx, newMTLSTestPod(cluster, "head-new", rayv1.HeadNode, time.Now(), true)))
+	restarting, err = controller.restartPodsWithOutdatedCertificates(ctx, cluster)
+	require.NoError(t, err)
+	assert.False(t, restarting)
+	assert.Empty(t, fakeRecorder.Events)
+}
+
+func TestRestartPodsWithOutdatedCertificatesNotReady(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	// A single replica of the worker group spans two hosts, so the worker group has two Pods.
+	cluster := newMTLSTestRayCluster()
+	cluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](1)
+	cluster.Spec.WorkerGroupSpecs[0].NumOfHosts = 2
+	rotationTime := time.Now().Add(-time.Hour)
+	cluster.Status.Certificates = &rayv1.CertificatesStatus{LastRotationTime: ptr.To(metav1.NewTime(rotationTime))}
+	before := rotationTime.Add(-time.Hour)
+	headPod := newMTLSTestPod(cluster, "head", rayv1.HeadNode, before, true)
+	workerA := newMTLSTestPod(cluster, "worker-a", rayv1.WorkerNode, before, true)
+	workerB := newMTLSTestPod(cluster, "worker-b", rayv1.WorkerNode, before, false)
+
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(cluster, headPod, workerA, workerB).Build()
+	controller := &RayClusterMTLSController{Client: fakeClient, Scheme: newScheme, Recorder: record.NewFakeRecorder(10)}
+	ctx := context.Background()
+	podExists := func(name string) bool {
+		return fakeClient.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: name}, &corev1.Pod{}) == nil
+	}
+
+	// The outdated worker Pod that is not ready is restarted first without waiting for it.
+	restarting, err := controller.restartPodsWithOutdatedCertificates(ctx, cluster)
+	require.NoError(t, err)
+	assert.True(t, restarting)
+	assert.False(t, podExists(workerB.Name))
+	assert.True(t, podExists(workerA.Name))
+
+	// The ready worker Pod is not restarted until the worker group has its two Pods again.
+	restarting, err = controller.restartPodsWithOutdatedCertificates(ctx, cluster)
+	require.NoError(t, err)
+	assert.True(t, restarting)
+	assert.True(t, podExists(workerA.Name))
+
+	// A recreated Pod that can't become ready doesn't block the restart forever.
+	stuckWorker := newMTLSTestPod(cluster, "worker-c", rayv1.WorkerNode, time.Now(), false)
+	require.NoError(t, fakeClient.Create(ctx, stuckWorker))
+	restarting, err = controller.restartPodsWithOutdatedCertificates(ctx, cluster)
+	require.NoError(t, err)
+	assert.True(t, restarting)
+	assert.True(t, podExists(workerA.Name))
+
+	stuckWorker.CreationTimestamp = metav1.NewTime(time.Now().Add(-RayClusterMTLSPodRestartTimeout))
+	require.NoError(t, fakeClient.Update(ctx, stuckWorker))
+	restarting, err = controller.restartPodsWithOutdatedCertificates(ctx, cluster)
+	require.NoError(t, err)
+	assert.True(t, restarting)
+	assert.False(t, podExists(workerA.Name))
+}
+
+func TestCertificatesWithDNSSANs(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+	_ = certmanagerv1.AddToScheme(newScheme)
+
+	cluster := newMTLSTestRayCluster()
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(cluster).Build()
+	controller := &RayClusterMTLSController{Client: fakeClient, Scheme: newScheme}
+	ctx := context.Background()
+	getCertificate := func(name string) *certmanagerv1.Certificate {
+		cert := &certmanagerv1.Certificate{}
+		require.NoError(t, fakeClient.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: name + "-" + cluster.Name}, cert))
+		return cert
+	}
+
+	// With pod IPs, the worker certificate lists the IP of every Pod.
+	require.NoError(t, controller.createRayWorkerCertificate(ctx, cluster, []string{"10.0.0.1", "10.0.0.2"}))
+	assert.Equal(t, []string{"10.0.0.1", "10.0.0.2", "127.0.0.1"}, getCertificate(rayWorkerCertName).Spec.IPAddresses)
+
+	// Switching to DNS SANs replaces the pod IPs with the DNS names of the headless worker service.
+	cluster.Spec.SecurityOptions.CertificateSANs = ptr.To(rayv1.DNSCertificateSANs)
+	require.NoError(t, controller.updateCertificateWithDNSNames(ctx, cluster, rayWorkerCertName+"-"+cluster.Name, false))
+	workerCert := getCertificate(rayWorkerCertName)
+	assert.Equal(t, []string{"127.0.0.1"}, workerCert.Spec.IPAddresses)
+	assert.Contains(t, workerCert.Spec.DNSNames, "*.raycluster-mtls-headless.default.svc")
+	assert.Contains(t, workerCert.Spec.DNSNames, "*.raycluster-mtls-headless.default.svc."+utils.GetClusterDomainName())
+
+	// The head certificate lists the head service, which may have a custom name.
+	cluster.Spec.HeadGroupSpec.HeadService = &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "custom-head-svc"}}
+	require.NoError(t, controller.createRayHeadCertificate(ctx, cluster, nil))
+	headCert := getCertificate(rayHeadCertName)
+	assert.Equal(t, []string{"127.0.0.1"}, headCert.Spec.IPAddresses)
+	assert.Contains(t, headCert.Spec.DNSNames, "custom-head-svc.default.svc."+utils.GetClusterDomainName())
+}
+
+func TestReconcileExternalIssuer(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+	_ = certmanagerv1.AddToScheme(newScheme)
+
+	cluster := newMTLSTestRayCluster()
+	// The CA issuer bootstrapped for the RayCluster before the external issuer was configured.
+	bootstrapIssuer := &certmanagerv1.Issuer{ObjectMeta: metav1.ObjectMeta{
+		Name:      caIssuerName + "-" + cluster.Name,
+		Namespace: cluster.Namespace,
+		Labels:    map[string]string{"ray.io/cluster-name": cluster.Name},
+	}}
+	// An issuer shared with other RayClusters that happens to have the name of a bootstrapped issuer.
+	sharedIssuer := &certmanagerv1.Issuer{ObjectMeta: metav1.ObjectMeta{
+		Name:      raySelfSignedIssuerName + "-" + cluster.Name,
+		Namespace: cluster.Namespace,
+	}}
+	clusterIssuer := &certmanagerv1.ClusterIssuer{ObjectMeta: metav1.ObjectMeta{Name: "corporate-ca"}}
+	workerCert := &certmanagerv1.Certificate{ObjectMeta: metav1.ObjectMeta{
+		Name:      rayWorkerCertName + "-" + cluster.Name,
+		Namespace: cluster.Namespace,
+	}}
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).
+		WithRuntimeObjects(cluster, bootstrapIssuer, sharedIssuer, clusterIssuer, workerCert).Build()
+	controller := &RayClusterMTLSController{Client: fakeClient, Scheme: newScheme, Config: &configapi.Configuration{
+		MTLSCertificateIssuer: &rayv1.CertificateIssuer{Name: "corporate-ca", Kind: "ClusterIssuer"},
+	}}
+	ctx := context.Background()
+	issuerExists := func(name string) bool {
+		return fakeClient.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: name}, &certmanagerv1.Issuer{}) == nil
+	}
+
+	// The default issuer of the operator is used, and only the issuer bootstrapped for the RayCluster is deleted.
+	issuer := utils.GetCertificateIssuer(cluster, controller.defaultCertificateIssuer())
+	require.NoError(t, controller.reconcileExternalIssuer(ctx, cluster, issuer))
+	assert.False(t, issuerExists(bootstrapIssuer.Name))
+	assert.True(t, issuerExists(sharedIssuer.Name))
+
+	// Existing certificates are pointed at the external issuer.
+	require.NoError(t, controller.updateCertificateIssuerRef(ctx, cluster, workerCert.Name))
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(workerCert), workerCert))
+	assert.Equal(t, "corporate-ca", workerCert.Spec.IssuerRef.Name)
+	assert.Equal(t, "ClusterIssuer", workerCert.Spec.IssuerRef.Kind)
+	assert.Equal(t, utils.DefaultCertificateIssuerGroup, workerCert.Spec.IssuerRef.Group)
+
+	// An issuer of the RayCluster that doesn't exist is reported.
+	cluster.Spec.SecurityOptions.CertificateIssuer = &rayv1.CertificateIssuer{Name: "missing-ca"}
+	issuer = utils.GetCertificateIssuer(cluster, controller.defaultCertificateIssuer())
+	require.ErrorContains(t, controller.reconcileExternalIssuer(ctx, cluster, issuer), "Issuer missing-ca not found")
+}
+
+func TestIsCertManagerInstalled(t *testing.T) {
+	restMapper := meta.NewDefaultRESTMapper(nil)
+	controller := &RayClusterMTLSController{RESTMapper: restMapper}
+	assert.False(t, controller.isCertManagerInstalled())
+
+	restMapper.Add(certmanagerv1.SchemeGroupVersion.WithKind("Certificate"), meta.RESTScopeNamespace)
+	assert.True(t, controller.isCertManagerInstalled())
+}
+
+func TestReconcileBuiltinPKI(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	cluster := newMTLSTestRayCluster()
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(cluster).Build()
+	controller := &RayClusterMTLSController{Client: fakeClient, Scheme: newScheme, Recorder: record.NewFakeRecorder(10)}
+	ctx := context.Background()
+	getSecret := func(name string) *corev1.Secret {
+		secret := &corev1.Secret{}
+		require.NoError(t, fakeClient.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: name + "-" + cluster.Name}, secret))
+		return secret
+	}
+	parseCertificate := func(name string) *x509.Certificate {
+		cert, _, err := parseKeyPair(getSecret(name))
+		require.NoError(t, err)
+		return cert
+	}
+
+	// The CA and the head and worker certificates are issued in the Secrets used with cert-manager.
+	require.NoError(t, controller.reconcileBuiltinPKI(ctx, cluster, []string{"10.0.0.1"}))
+	caCert := parseCertificate(caSecretName)
+	assert.True(t, caCert.IsCA)
+	for _, name := range []string{rayHeadSecretName, rayWorkerSecretName} {
+		secret := getSecret(name)
+		assert.Equal(t, corev1.SecretTypeTLS, secret.Type)
+		assert.Equal(t, getSecret(caSecretName).Data[corev1.TLSCertKey], secret.Data["ca.crt"])
+		cert := parseCertificate(name)
+		require.NoError(t, cert.CheckSignatureFrom(caCert))
+		assert.ElementsMatch(t, []string{"10.0.0.1", "127.0.0.1"}, ipStrings(cert.IPAddresses))
+	}
+	headCert := parseCertificate(rayHeadSecretName)
+	assert.Contains(t, headCert.DNSNames, "raycluster-mtls-head-svc.default.svc")
+
+	// The certificates are not reissued if they are still valid.
+	require.NoError(t, controller.reconcileBuiltinPKI(ctx, cluster, []string{"10.0.0.1"}))
+	assert.Equal(t, headCert.SerialNumber, parseCertificate(rayHeadSecretName).SerialNumber)
+
+	// The certificates are reissued when the pod IPs change.
+	require.NoError(t, controller.reconcileBuiltinPKI(ctx, cluster, []string{"10.0.0.1", "10.0.0.2"}))
+	assert.NotEqual(t, headCert.SerialNumber, parseCertificate(rayHeadSecretName).SerialNumber)
+	assert.ElementsMatch(t, []string{"10.0.0.1", "10.0.0.2", "127.0.0.1"}, ipStrings(parseCertificate(rayWorkerSecretName).IPAddresses))
+
+	// The certificates are reissued by the new CA when the CA is replaced.
+	require.NoError(t, fakeClient.Delete(ctx, getSecret(caSecretName)))
+	require.NoError(t, controller.reconcileBuiltinPKI(ctx, cluster, []string{"10.0.0.1", "10.0.0.2"}))
+	newCACert := parseCertificate(caSecretName)
+	assert.NotEqual(t, caCert.SerialNumber, newCACert.SerialNumber)
+	require.NoError(t, parseCertificate(rayWorkerSecretName).CheckSignatureFrom(newCACert))
+
+	// The expiry of the certificates is reported without cert-manager Certificates.
+	instance := cluster.DeepCopy()
+	instance.Status.Certificates = &rayv1.CertificatesStatus{}
+	require.NoError(t, controller.checkCertificateExpiry(ctx, instance))
+	require.NotNil(t, instance.Status.Certificates.NotAfter)
+	assert.True(t, instance.Status.Certificates.NotAfter.Time.Equal(parseCertificate(rayHeadSecretName).NotAfter))
+
+	// An external issuer cannot be used without cert-manager.
+	cluster.Spec.SecurityOptions.CertificateIssuer = &rayv1.CertificateIssuer{Name: "corporate-ca"}
+	require.ErrorContains(t, controller.reconcileBuiltinPKI(ctx, cluster, nil), "requires cert-manager")
+}
+
+func TestCertificateConditions(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+	_ = certmanagerv1.AddToScheme(newScheme)
+
+	cluster := newMTLSTestRayCluster()
+	notAfter := metav1.NewTime(time.Now().Add(3 * 24 * time.Hour))
+	headCert := &certmanagerv1.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Name: rayHeadCertName + "-" + cluster.Name, Namespace: cluster.Namespace},
+		Status: certmanagerv1.CertificateStatus{
+			NotAfter: &notAfter,
+			Conditions: []certmanagerv1.CertificateCondition{
+				{Type: certmanagerv1.CertificateConditionReady, Status: cmmeta.ConditionTrue},
+				{Type: certmanagerv1.CertificateConditionIssuing, Status: cmmeta.ConditionFalse, Reason: "Failed", Message: "issuer not ready"},
+			},
+		},
+	}
+	workerCert := &certmanagerv1.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Name: rayWorkerCertName + "-" + cluster.Name, Namespace: cluster.Namespace},
+		Status: certmanagerv1.CertificateStatus{
+			Conditions: []certmanagerv1.CertificateCondition{{Type: certmanagerv1.CertificateConditionReady, Status: cmmeta.ConditionTrue}},
+		},
+	}
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(cluster, headCert, workerCert).Build()
+	fakeRecorder := record.NewFakeRecorder(10)
+	controller := &RayClusterMTLSController{Client: fakeClient, Scheme: newScheme, Recorder: fakeRecorder}
+	ctx := context.Background()
+
+	// A failed renewal of cert-manager is reported as an issuance failure.
+	ready, err := controller.checkCertificatesReady(ctx, cluster)
+	assert.False(t, ready)
+	require.ErrorContains(t, err, "issuer not ready")
+	assert.True(t, setCertificatesReadyCondition(cluster, ready, err))
+	condition := meta.FindStatusCondition(cluster.Status.Conditions, string(rayv1.RayClusterCertificatesReady))
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, rayv1.CertificatesIssuanceFailed, condition.Reason)
+	// The same failure doesn't change the condition again.
+	assert.False(t, setCertificatesReadyCondition(cluster, ready, err))
+
+	assert.True(t, setCertificatesReadyCondition(cluster, false, nil))
+	assert.Equal(t, rayv1.CertificatesPending, meta.FindStatusCondition(cluster.Status.Conditions, string(rayv1.RayClusterCertificatesReady)).Reason)
+	assert.True(t, setCertificatesReadyCondition(cluster, true, nil))
+	assert.True(t, meta.IsStatusConditionTrue(cluster.Status.Conditions, string(rayv1.RayClusterCertificatesReady)))
+
+	// A certificate that expires within the threshold sets the CertificateExpiringSoon condition, with an event.
+	cluster.Status.Certificates = &rayv1.CertificatesStatus{}
+	require.NoError(t, controller.checkCertificateExpiry(ctx, cluster))
+	assert.True(t, meta.IsStatusConditionTrue(cluster.Status.Conditions, string(rayv1.RayClusterCertificateExpiringSoon)))
+	assert.Len(t, fakeRecorder.Events, 1)
+	assert.Contains(t, <-fakeRecorder.Events, string(utils.CertificateExpiringSoon))
+
+	// The event is only emitted when the condition becomes true.
+	require.NoError(t, controller.checkCertificateExpiry(ctx, cluster))
+	assert.Empty(t, fakeRecorder.Events)
+
+	// The condition is cleared once the certificate is renewed.
+	headCert.Status.NotAfter = ptr.To(metav1.NewTime(time.Now().Add(90 * 24 * time.Hour)))
+	require.NoError(t, fakeClient.Update(ctx, headCert))
+	require.NoError(t, controller.checkCertificateExpiry(ctx, cluster))
+	condition = meta.FindStatusCondition(cluster.Status.Conditions, string(rayv1.RayClusterCertificateExpiringSoon))
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, rayv1.CertificatesValid, condition.Reason)
+}
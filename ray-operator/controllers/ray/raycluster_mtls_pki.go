@@ -0,0 +1,279 @@
+package ray
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+// The lifetimes of the certificates issued by the built-in PKI, which match the certificates issued with cert-manager
+const (
+	builtinCACertificateDuration    = 24 * time.Hour * 3650 // ~10 years
+	builtinCACertificateRenewBefore = 24 * time.Hour * 30   // renew 30 days before expiry
+	builtinCertificateDuration      = 2160 * time.Hour      // 90 days
+	builtinCertificateRenewBefore   = 360 * time.Hour       // 15 days
+)
+
+// reconcileBuiltinPKI issues the CA and the head and worker certificates of the RayCluster without cert-manager.
+// The certificates are stored in Secrets with the same names and keys as the Secrets of cert-manager, so that the
+// Ray Pods mount them the same way, and are renewed before they expire or when their SANs change.
+func (r *RayClusterMTLSController) reconcileBuiltinPKI(ctx context.Context, instance *rayv1.RayCluster, podIPs []string) error {
+	if issuer := utils.GetCertificateIssuer(instance, r.defaultCertificateIssuer()); issuer != nil {
+		return fmt.Errorf("certificate issuer %s requires cert-manager, which is not installed", issuer.Name)
+	}
+
+	caCert, caKey, err := r.reconcileBuiltinCA(ctx, instance)
+	if err != nil {
+		return err
+	}
+
+	headDNSNames, err := headCertificateDNSNames(instance)
+	if err != nil {
+		return err
+	}
+	headSecretName := fmt.Sprintf("%s-%s", rayHeadSecretName, instance.Name)
+	if err := r.reconcileBuiltinCertificate(ctx, instance, headSecretName, "head-certificate", headDNSNames, podIPs, caCert, caKey); err != nil {
+		return err
+	}
+
+	workerDNSNames, err := workerCertificateDNSNames(instance)
+	if err != nil {
+		return err
+	}
+	workerSecretName := fmt.Sprintf("%s-%s", rayWorkerSecretName, instance.Name)
+	return r.reconcileBuiltinCertificate(ctx, instance, workerSecretName, "worker-certificate", workerDNSNames, podIPs, caCert, caKey)
+}
+
+// reconcileBuiltinCA returns the CA of the RayCluster, and issues a new self-signed CA if there is none or if it
+// is about to expire
+func (r *RayClusterMTLSController) reconcileBuiltinCA(ctx context.Context, instance *rayv1.RayCluster) (*x509.Certificate, crypto.Signer, error) {
+	logger := log.FromContext(ctx)
+	secretName := fmt.Sprintf("%s-%s", caSecretName, instance.Name)
+
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, client.ObjectKey{Name: secretName, Namespace: instance.Namespace}, secret)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, nil, err
+	}
+	if err == nil {
+		cert, key, err := parseKeyPair(secret)
+		if err == nil && cert.IsCA && !shouldRenewCertificate(cert, builtinCACertificateRenewBefore) {
+			return cert, key, nil
+		}
+		reason := "the CA is about to expire"
+		if err != nil {
+			reason = err.Error()
+		}
+		logger.Info("Reissuing the CA of the RayCluster", "secret", secretName, "reason", reason)
+	}
+
+	template := &x509.Certificate{
+		Subject:               pkix.Name{CommonName: fmt.Sprintf("%s-%s", "ray-root-ca", instance.Name)},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(builtinCACertificateDuration),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	certPEM, keyPEM, err := issueCertificate(template, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := r.writeCertificateSecret(ctx, instance, secretName, "ca-certificate", certPEM, keyPEM, certPEM); err != nil {
+		return nil, nil, err
+	}
+	r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.IssuedCertificate),
+		"Issued the mTLS CA of RayCluster %s/%s in Secret %s", instance.Namespace, instance.Name, secretName)
+
+	return parseKeyPair(&corev1.Secret{Data: map[string][]byte{corev1.TLSCertKey: certPEM, corev1.TLSPrivateKeyKey: keyPEM}})
+}
+
+// reconcileBuiltinCertificate issues a certificate signed by the CA of the RayCluster into a Secret, unless the
+// Secret already holds a certificate of the CA for the same SANs that is not about to expire
+func (r *RayClusterMTLSController) reconcileBuiltinCertificate(ctx context.Context, instance *rayv1.RayCluster, secretName, component string, dnsNames, podIPs []string, caCert *x509.Certificate, caKey crypto.Signer) error {
+	logger := log.FromContext(ctx)
+	dnsNames = uniqueStrings(dnsNames)
+	var ipAddresses []net.IP
+	for _, ip := range normalizeIPs(podIPs) {
+		if parsed := net.ParseIP(ip); parsed != nil {
+			ipAddresses = append(ipAddresses, parsed)
+		}
+	}
+
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, client.ObjectKey{Name: secretName, Namespace: instance.Namespace}, secret)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	if err == nil {
+		reason := certificateReissueReason(secret, dnsNames, ipAddresses, caCert)
+		if reason == "" {
+			return nil
+		}
+		logger.Info("Reissuing certificate", "secret", secretName, "reason", reason)
+	}
+
+	template := &x509.Certificate{
+		Subject:     pkix.Name{CommonName: secretName},
+		NotBefore:   time.Now(),
+		NotAfter:    time.Now().Add(builtinCertificateDuration),
+		KeyUsage:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:    dnsNames,
+		IPAddresses: ipAddresses,
+	}
+	certPEM, keyPEM, err := issueCertificate(template, caCert, caKey)
+	if err != nil {
+		return err
+	}
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caCert.Raw})
+	if err := r.writeCertificateSecret(ctx, instance, secretName, component, certPEM, keyPEM, caPEM); err != nil {
+		return err
+	}
+	r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.IssuedCertificate),
+		"Issued the mTLS certificate of RayCluster %s/%s in Secret %s", instance.Namespace, instance.Name, secretName)
+	return nil
+}
+
+// certificateReissueReason returns why the certificate of a Secret must be reissued, or an empty string if it is
+// still valid for the given SANs and CA
+func certificateReissueReason(secret *corev1.Secret, dnsNames []string, ipAddresses []net.IP, caCert *x509.Certificate) string {
+	cert, _, err := parseKeyPair(secret)
+	if err != nil {
+		return err.Error()
+	}
+	if shouldRenewCertificate(cert, builtinCertificateRenewBefore) {
+		return "the certificate is about to expire"
+	}
+	if !setsEqual(cert.DNSNames, dnsNames) || !setsEqual(ipStrings(cert.IPAddresses), ipStrings(ipAddresses)) {
+		return "the SANs of the certificate changed"
+	}
+	if err := cert.CheckSignatureFrom(caCert); err != nil {
+		return "the certificate is not signed by the current CA"
+	}
+	return ""
+}
+
+// writeCertificateSecret creates or updates a Secret with a certificate, its private key and its CA
+func (r *RayClusterMTLSController) writeCertificateSecret(ctx context.Context, instance *rayv1.RayCluster, name, component string, certPEM, keyPEM, caPEM []byte) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: instance.Namespace},
+	}
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		if secret.CreationTimestamp.IsZero() {
+			secret.Type = corev1.SecretTypeTLS
+		}
+		if secret.Labels == nil {
+			secret.Labels = map[string]string{}
+		}
+		secret.Labels["app.kubernetes.io/name"] = "ray-mtls"
+		secret.Labels["app.kubernetes.io/component"] = component
+		secret.Labels["ray.io/cluster-name"] = instance.Name
+		secret.Data = map[string][]byte{
+			corev1.TLSCertKey:       certPEM,
+			corev1.TLSPrivateKeyKey: keyPEM,
+			"ca.crt":                caPEM,
+		}
+		return ctrl.SetControllerReference(instance, secret, r.Scheme)
+	})
+	return err
+}
+
+// issueCertificate generates a new RSA key and a certificate for it signed by the given issuer, or self-signed if
+// there is no issuer, and returns them PEM-encoded
+func issueCertificate(template, issuer *x509.Certificate, issuerKey crypto.Signer) ([]byte, []byte, error) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, nil, err
+	}
+	if issuer == nil {
+		issuer, issuerKey = template, privateKey
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+	template.SerialNumber = serialNumber
+
+	certDER, err := x509.CreateCertificate(rand.Reader, template, issuer, &privateKey.PublicKey, issuerKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+	return certPEM, keyPEM, nil
+}
+
+// parseKeyPair parses the certificate and private key of a TLS Secret, which may also have been issued by
+// cert-manager before it was uninstalled
+func parseKeyPair(secret *corev1.Secret) (*x509.Certificate, crypto.Signer, error) {
+	certBlock, _ := pem.Decode(secret.Data[corev1.TLSCertKey])
+	if certBlock == nil {
+		return nil, nil, fmt.Errorf("secret %s has no PEM-encoded certificate", secret.Name)
+	}
+	cert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyBlock, _ := pem.Decode(secret.Data[corev1.TLSPrivateKeyKey])
+	if keyBlock == nil {
+		return nil, nil, fmt.Errorf("secret %s has no PEM-encoded private key", secret.Name)
+	}
+	var key any
+	switch keyBlock.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(keyBlock.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(keyBlock.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(keyBlock.Bytes)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, nil, fmt.Errorf("secret %s has an unsupported private key", secret.Name)
+	}
+	return cert, signer, nil
+}
+
+// shouldRenewCertificate returns whether a certificate expires within the given duration
+func shouldRenewCertificate(cert *x509.Certificate, renewBefore time.Duration) bool {
+	return time.Now().Add(renewBefore).After(cert.NotAfter)
+}
+
+// ipStrings converts IPs to their canonical string form
+func ipStrings(ips []net.IP) []string {
+	out := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		out = append(out, ip.String())
+	}
+	return out
+}
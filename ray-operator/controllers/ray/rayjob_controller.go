@@ -24,6 +24,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler"
+	schedulerinterface "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/interface"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/common"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/metrics"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
@@ -48,6 +50,9 @@ type RayJobReconciler struct {
 
 type RayJobReconcilerOptions struct {
 	RayJobMetricsManager *metrics.RayJobMetricsManager
+	// BatchSchedulerMgr is the batch scheduler manager of the RayCluster reconciler. It is used to
+	// schedule the submitter pod of a RayJob in the gang of its RayCluster.
+	BatchSchedulerMgr *batchscheduler.SchedulerManager
 }
 
 // NewRayJobReconciler returns a new reconcile.Reconciler
@@ -525,6 +530,7 @@ func (r *RayJobReconciler) createK8sJobIfNeed(ctx context.Context, rayJobInstanc
 			if err != nil {
 				return err
 			}
+			r.addBatchSchedulerMetadataToSubmitter(ctx, rayJobInstance, rayClusterInstance, &submitterTemplate)
 			return r.createNewK8sJob(ctx, rayJobInstance, submitterTemplate)
 		}
 		return err
@@ -583,6 +589,27 @@ func getSubmitterTemplate(ctx context.Context, rayJobInstance *rayv1.RayJob, ray
 	return submitterTemplate, nil
 }
 
+// addBatchSchedulerMetadataToSubmitter adds the metadata of the batch scheduler to the submitter pod template, so that
+// the submitter pod is scheduled in the gang of the RayCluster created by the RayJob.
+func (r *RayJobReconciler) addBatchSchedulerMetadataToSubmitter(ctx context.Context, rayJobInstance *rayv1.RayJob, rayClusterInstance *rayv1.RayCluster, submitterTemplate *corev1.PodTemplateSpec) {
+	// The gang of a RayCluster selected with the cluster selector doesn't include the submitter pod.
+	if r.options.BatchSchedulerMgr == nil || len(rayJobInstance.Spec.ClusterSelector) != 0 {
+		return
+	}
+	// The RayCluster controller reports a scheduler that isn't enabled, and falls back to the default scheduler.
+	scheduler, _ := r.options.BatchSchedulerMgr.GetSchedulerForCluster(rayClusterInstance)
+	pod := corev1.Pod{ObjectMeta: submitterTemplate.ObjectMeta, Spec: submitterTemplate.Spec}
+	if pod.Labels == nil {
+		pod.Labels = map[string]string{}
+	}
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	scheduler.AddMetadataToPod(ctx, rayClusterInstance, rayJobInstance, schedulerinterface.SubmitterGroupName, &pod)
+	submitterTemplate.ObjectMeta = pod.ObjectMeta
+	submitterTemplate.Spec = pod.Spec
+}
+
 // createNewK8sJob creates a new Kubernetes Job. It returns an error.
 func (r *RayJobReconciler) createNewK8sJob(ctx context.Context, rayJobInstance *rayv1.RayJob, submitterTemplate corev1.PodTemplateSpec) error {
 	logger := ctrl.LoggerFrom(ctx)
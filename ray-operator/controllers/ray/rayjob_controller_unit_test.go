@@ -23,7 +23,12 @@ import (
 	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
+	configapi "github.com/ray-project/kuberay/ray-operator/apis/config/v1alpha1"
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler"
+	schedulerinterface "github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/interface"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/batchscheduler/yunikorn"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/common"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/metrics/mocks"
 	utils "github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
 	"github.com/ray-project/kuberay/ray-operator/pkg/client/clientset/versioned/scheme"
@@ -99,6 +104,79 @@ func TestCreateRayJobSubmitterIfNeed(t *testing.T) {
 	assert.Equal(t, k8sJob.Labels[utils.RayOriginatedFromCRDLabelKey], utils.RayOriginatedFromCRDLabelValue(utils.RayJobCRD))
 }
 
+func TestCreateRayJobSubmitterWithBatchScheduler(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = batchv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	rayCluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-raycluster",
+			Namespace: "default",
+			Labels: map[string]string{
+				yunikorn.RayClusterApplicationIDLabelName: "rayjob-app",
+				utils.RayClusterGangSchedulingEnabled:     "true",
+			},
+		},
+		Spec: rayv1.RayClusterSpec{
+			HeadGroupSpec: rayv1.HeadGroupSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Image: "rayproject/ray",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	rayJob := &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rayjob",
+			Namespace: "default",
+		},
+		Spec: rayv1.RayJobSpec{
+			SubmissionMode: rayv1.K8sJobMode,
+		},
+	}
+
+	schedulerMgr, err := batchscheduler.NewSchedulerManager(context.TODO(), configapi.Configuration{BatchScheduler: yunikorn.GetPluginName()}, nil)
+	require.NoError(t, err)
+
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(rayCluster, rayJob).Build()
+	ctx := context.TODO()
+	rayJobReconciler := &RayJobReconciler{
+		Client:   fakeClient,
+		Scheme:   newScheme,
+		Recorder: &record.FakeRecorder{},
+		options:  RayJobReconcilerOptions{BatchSchedulerMgr: schedulerMgr},
+	}
+
+	err = rayJobReconciler.createK8sJobIfNeed(ctx, rayJob, rayCluster)
+	require.NoError(t, err)
+
+	// The submitter pod is scheduled in the gang of the RayCluster.
+	k8sJob := &batchv1.Job{}
+	err = fakeClient.Get(ctx, common.RayJobK8sJobNamespacedName(rayJob), k8sJob)
+	require.NoError(t, err)
+	submitterTemplate := k8sJob.Spec.Template
+	assert.Equal(t, yunikorn.GetPluginName(), submitterTemplate.Spec.SchedulerName)
+	assert.Equal(t, "rayjob-app", submitterTemplate.Labels[yunikorn.YuniKornPodApplicationIDLabelName])
+	assert.Equal(t, schedulerinterface.SubmitterGroupName, submitterTemplate.Annotations[yunikorn.YuniKornTaskGroupNameAnnotationName])
+	assert.Contains(t, submitterTemplate.Annotations[yunikorn.YuniKornTaskGroupsAnnotationName], schedulerinterface.SubmitterGroupName)
+
+	// The submitter pod of a RayJob that selects an existing RayCluster isn't part of its gang.
+	rayJob.Spec.ClusterSelector = map[string]string{utils.RayClusterLabelKey: rayCluster.Name}
+	submitterTemplate = corev1.PodTemplateSpec{}
+	rayJobReconciler.addBatchSchedulerMetadataToSubmitter(ctx, rayJob, rayCluster, &submitterTemplate)
+	assert.Empty(t, submitterTemplate.Spec.SchedulerName)
+	assert.Empty(t, submitterTemplate.Annotations)
+}
+
 func TestGetSubmitterTemplate(t *testing.T) {
 	// RayJob instance with user-provided submitter pod template.
 	rayJobInstanceWithTemplate := &rayv1.RayJob{
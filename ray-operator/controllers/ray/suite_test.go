@@ -135,7 +135,7 @@ var _ = BeforeSuite(func(ctx SpecContext) {
 	Expect(err).NotTo(HaveOccurred(), "failed to setup RayJob controller")
 
 	// NetworkPolicy controller
-	networkPolicyController := NewNetworkPolicyController(mgr)
+	networkPolicyController := NewNetworkPolicyController(mgr, &configs)
 	err = networkPolicyController.SetupWithManager(mgr)
 	Expect(err).NotTo(HaveOccurred(), "failed to setup NetworkPolicy controller")
 
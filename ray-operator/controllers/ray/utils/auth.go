@@ -1,6 +1,13 @@
 package utils
 
 import (
+	"context"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
 )
 
@@ -14,35 +21,45 @@ const (
 	ModeOIDC AuthenticationMode = "OIDC"
 )
 
-// DetectAuthenticationMode determines whether the cluster is using OAuth or OIDC
-// Returns IntegratedOAuth by default when no specific authentication is configured
-func DetectAuthenticationMode(isOpenShift bool) AuthenticationMode {
-	// First, check if we're on OpenShift
+// ClusterAuthenticationName is the name of the cluster-wide Authentication resource of OpenShift
+const ClusterAuthenticationName = "cluster"
+
+// DetectAuthenticationMode determines whether the cluster is using OAuth or OIDC from the type of the cluster-wide
+// Authentication resource of OpenShift. Returns IntegratedOAuth by default when no specific authentication is
+// configured, or when the cluster is not OpenShift.
+func DetectAuthenticationMode(ctx context.Context, c client.Reader, isOpenShift bool) (AuthenticationMode, error) {
 	if !isOpenShift {
 		// Default to IntegratedOAuth for non-OpenShift clusters
-		return ModeIntegratedOAuth
+		return ModeIntegratedOAuth, nil
 	}
 
-	// Default to IntegratedOAuth when no specific authentication is configured
+	authentication := &configv1.Authentication{}
+	if err := c.Get(ctx, client.ObjectKey{Name: ClusterAuthenticationName}, authentication); err != nil {
+		if errors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			// Default to IntegratedOAuth when no specific authentication is configured
+			return ModeIntegratedOAuth, nil
+		}
+		return "", err
+	}
+	return AuthenticationModeForType(authentication.Spec.Type), nil
+}
+
+// AuthenticationModeForType returns the authentication mode for the type of the Authentication resource of OpenShift
+func AuthenticationModeForType(authType configv1.AuthenticationType) AuthenticationMode {
+	if authType == configv1.AuthenticationTypeOIDC {
+		return ModeOIDC
+	}
 	return ModeIntegratedOAuth
 }
 
-// ShouldEnableOAuth determines if OAuth should be enabled based on the cluster annotation and authentication mode
-// Returns true only if the cluster has the enable-authentication annotation set to "true" AND the authentication mode is IntegratedOAuth
+// ShouldEnableOAuth determines if OAuth should be enabled based on the cluster security options and authentication mode
+// Returns true only if the auth proxy is enabled for the cluster AND the authentication mode is IntegratedOAuth
 func ShouldEnableOAuth(cluster *rayv1.RayCluster, authMode AuthenticationMode) bool {
-	if cluster == nil || cluster.Annotations == nil {
-		return false
-	}
-	enableAuth := cluster.Annotations[EnableSecureTrustedNetworkAnnotationKey]
-	return enableAuth == "true" && authMode == ModeIntegratedOAuth
+	return IsAuthProxyEnabled(cluster) && authMode == ModeIntegratedOAuth
 }
 
-// ShouldEnableOIDC determines if OIDC should be enabled based on the cluster annotation and authentication mode
-// Returns true only if the cluster has the enable-authentication annotation set to "true" AND the authentication mode is OIDC
+// ShouldEnableOIDC determines if OIDC should be enabled based on the cluster security options and authentication mode
+// Returns true only if the auth proxy is enabled for the cluster AND the authentication mode is OIDC
 func ShouldEnableOIDC(cluster *rayv1.RayCluster, authMode AuthenticationMode) bool {
-	if cluster == nil || cluster.Annotations == nil {
-		return false
-	}
-	enableAuth := cluster.Annotations[EnableSecureTrustedNetworkAnnotationKey]
-	return enableAuth == "true" && authMode == ModeOIDC
+	return IsAuthProxyEnabled(cluster) && authMode == ModeOIDC
 }
@@ -1,8 +1,10 @@
 package utils
 
 import (
+	"encoding/json"
 	"fmt"
 
+	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -123,6 +125,12 @@ func (r *ResourceNamer) ConfigMapName() string {
 	return "kube-rbac-proxy-config-" + r.Cluster.Name
 }
 
+// DashboardAccessRoleName returns the name of the Role and RoleBinding that grant the users and groups of the
+// dashboard access policy access to the Ray dashboard
+func (r *ResourceNamer) DashboardAccessRoleName() string {
+	return r.Cluster.Name + "-dashboard-access"
+}
+
 // ProbeConfig holds common probe configuration
 type ProbeConfig struct {
 	Path                string
@@ -230,3 +238,29 @@ func CreateEnvVarFromSecret(envName, secretName, secretKey string) corev1.EnvVar
 func FormatOAuthDelegateURLs(namespace string) string {
 	return fmt.Sprintf(`{"/":{"resource":"pods","namespace":"%s","verb":"get"}}`, namespace)
 }
+
+// FormatDashboardDelegateURLs formats the OpenShift OAuth delegate URLs of the RayCluster, which check the dashboard
+// access policy of the RayCluster if it has one
+func FormatDashboardDelegateURLs(cluster *rayv1.RayCluster) string {
+	attributes := GetDashboardAccessAttributes(cluster)
+	if attributes == nil {
+		return FormatOAuthDelegateURLs(cluster.Namespace)
+	}
+	return fmt.Sprintf(`{"/":%s}`, formatResourceAttributes(attributes))
+}
+
+// FormatDashboardSAR formats the SubjectAccessReview that the OpenShift OAuth proxy checks when a user logs in to the
+// Ray dashboard, or returns an empty string if the RayCluster has no dashboard access policy
+func FormatDashboardSAR(cluster *rayv1.RayCluster) string {
+	attributes := GetDashboardAccessAttributes(cluster)
+	if attributes == nil {
+		return ""
+	}
+	return formatResourceAttributes(attributes)
+}
+
+func formatResourceAttributes(attributes *authorizationv1.ResourceAttributes) string {
+	// Marshalling a struct of strings cannot fail
+	data, _ := json.Marshal(attributes)
+	return string(data)
+}
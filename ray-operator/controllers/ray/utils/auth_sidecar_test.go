@@ -274,3 +274,16 @@ func TestFormatOAuthDelegateURLs(t *testing.T) {
 	expected := `{"/":{"resource":"pods","namespace":"test-namespace","verb":"get"}}`
 	assert.Equal(t, expected, result)
 }
+
+func TestFormatDashboardDelegateURLs(t *testing.T) {
+	cluster := &rayv1.RayCluster{ObjectMeta: metav1.ObjectMeta{Name: "raycluster", Namespace: "ml"}}
+	assert.JSONEq(t, FormatOAuthDelegateURLs("ml"), FormatDashboardDelegateURLs(cluster))
+	assert.Empty(t, FormatDashboardSAR(cluster))
+
+	cluster.Spec.SecurityOptions = &rayv1.SecurityOptions{
+		DashboardAccessPolicy: &rayv1.DashboardAccessPolicy{Users: []string{"alice"}},
+	}
+	sar := `{"namespace":"ml","verb":"get","group":"ray.io","resource":"rayclusters","subresource":"dashboard","name":"raycluster"}`
+	assert.JSONEq(t, `{"/":`+sar+`}`, FormatDashboardDelegateURLs(cluster))
+	assert.JSONEq(t, sar, FormatDashboardSAR(cluster))
+}
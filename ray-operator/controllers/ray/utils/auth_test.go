@@ -1,12 +1,16 @@
 package utils
 
 import (
+	"context"
 	"testing"
 
 	configv1 "github.com/openshift/api/config/v1"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
 )
@@ -25,7 +29,7 @@ func TestDetectAuthenticationMode(t *testing.T) {
 			expected:    ModeIntegratedOAuth,
 		},
 		{
-			name:        "OpenShift with OIDC providers - returns ModeIntegratedOAuth (OIDC detection is disabled)",
+			name:        "OpenShift with OIDC authentication type - returns ModeOIDC",
 			isOpenShift: true,
 			objects: []client.Object{
 				&configv1.Authentication{
@@ -33,13 +37,44 @@ func TestDetectAuthenticationMode(t *testing.T) {
 						Name: "cluster",
 					},
 					Spec: configv1.AuthenticationSpec{
+						Type: configv1.AuthenticationTypeOIDC,
 						OIDCProviders: []configv1.OIDCProvider{
 							{Name: "test-oidc"},
 						},
 					},
 				},
 			},
-			expected: ModeIntegratedOAuth, // Changed: OIDC detection is commented out in implementation
+			expected: ModeOIDC,
+		},
+		{
+			name:        "OpenShift with IntegratedOAuth authentication type - returns ModeIntegratedOAuth",
+			isOpenShift: true,
+			objects: []client.Object{
+				&configv1.Authentication{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cluster",
+					},
+					Spec: configv1.AuthenticationSpec{
+						Type: configv1.AuthenticationTypeIntegratedOAuth,
+					},
+				},
+			},
+			expected: ModeIntegratedOAuth,
+		},
+		{
+			name:        "Not OpenShift with OIDC authentication type - returns ModeIntegratedOAuth (default)",
+			isOpenShift: false,
+			objects: []client.Object{
+				&configv1.Authentication{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cluster",
+					},
+					Spec: configv1.AuthenticationSpec{
+						Type: configv1.AuthenticationTypeOIDC,
+					},
+				},
+			},
+			expected: ModeIntegratedOAuth,
 		},
 		{
 			name:        "OpenShift with OAuth identity providers - returns ModeIntegratedOAuth",
@@ -93,8 +128,13 @@ func TestDetectAuthenticationMode(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			require.NoError(t, configv1.Install(scheme))
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tc.objects...).Build()
+
 			// Test
-			result := DetectAuthenticationMode(tc.isOpenShift)
+			result, err := DetectAuthenticationMode(context.Background(), fakeClient, tc.isOpenShift)
+			require.NoError(t, err)
 			assert.Equal(t, tc.expected, result)
 		})
 	}
@@ -26,10 +26,59 @@ const (
 	HashWithoutReplicasAndWorkersToDeleteKey = "ray.io/hash-without-replicas-and-workers-to-delete"
 	NumWorkerGroupsKey                       = "ray.io/num-worker-groups"
 	KubeRayVersion                           = "ray.io/kuberay-version"
+	// RayWorkerGroupTemplateHashKey is the annotation on worker Pods that stores the hash of the worker group template
+	// the Pod was created from. It is used by the worker group update strategy to find outdated Pods.
+	RayWorkerGroupTemplateHashKey = "ray.io/worker-group-template-hash"
+	// RayWorkerDrainStartTimeKey is the annotation on worker Pods whose Ray node is being drained before the Pod is
+	// deleted. Its value is the RFC 3339 time at which the drain was requested.
+	RayWorkerDrainStartTimeKey = "ray.io/drain-start-time"
+	// RayWorkerReplicaIndexKey and RayHostIndexKey are the labels on the Pods of a multi-host worker group, i.e. a group
+	// with NumOfHosts > 1. All the Pods of a replica share the same replica index, and each of them has a distinct host
+	// index between 0 and NumOfHosts - 1. KubeRay uses them to recreate and scale down a replica as a whole.
+	RayWorkerReplicaIndexKey = "ray.io/worker-group-replica-index"
+	RayHostIndexKey          = "ray.io/replica-host-index"
+	// RayVolumeClaimIndexKey is the label on the Pods of a group with volumeClaimTemplates and on their
+	// PersistentVolumeClaims. The claims are named after the index, so that a Pod replacing a deleted Pod with the same
+	// index mounts the claims retained from it, like the Pods of a StatefulSet.
+	RayVolumeClaimIndexKey = "ray.io/volume-claim-index"
 
 	// NetworkPolicy annotation key - when present on a RayCluster, enables NetworkPolicy creation
 	EnableSecureTrustedNetworkAnnotationKey = "odh.ray.io/secure-trusted-network"
 
+	// The authentication mode that the auth proxy sidecar of a head Pod was configured for. KubeRay recreates the
+	// head Pod when the authentication mode of the cluster changes, so that its sidecar is swapped.
+	RayAuthenticationModeAnnotationKey = "ray.io/authentication-mode"
+
+	// The hash of the auth proxy sidecar of a head Pod. KubeRay recreates the head Pod when the sidecar built from
+	// the authProxy configuration of the operator has another hash.
+	RayAuthProxyHashAnnotationKey = "ray.io/auth-proxy-hash"
+
+	// Annotations that override the parent Gateway, hostname, and path of the HTTPRoute of the Ray dashboard of a
+	// RayCluster, which are otherwise set by the dashboardGateway configuration of the operator.
+	RayDashboardGatewayNameAnnotationKey        = "ray.io/dashboard-gateway-name"
+	RayDashboardGatewayNamespaceAnnotationKey   = "ray.io/dashboard-gateway-namespace"
+	RayDashboardGatewaySectionNameAnnotationKey = "ray.io/dashboard-gateway-section-name"
+	RayDashboardHostnameAnnotationKey           = "ray.io/dashboard-hostname"
+	RayDashboardPathTemplateAnnotationKey       = "ray.io/dashboard-path-template"
+
+	// The defaults of the parent Gateway and path of the HTTPRoute of the Ray dashboard
+	DefaultDashboardGatewayName      = "data-science-gateway"
+	DefaultDashboardGatewayNamespace = "openshift-ingress"
+	DefaultDashboardPathTemplate     = "/ray/{namespace}/{name}"
+
+	// The key of the URL of the Ray dashboard behind the auth proxy in the RayCluster status.endpoints
+	DashboardURLEndpointKey = "dashboard-url"
+
+	// The defaults of the kind and group of an mTLS certificate issuer, as in cert-manager
+	DefaultCertificateIssuerKind  = "Issuer"
+	DefaultCertificateIssuerGroup = "cert-manager.io"
+
+	// The users and groups of a dashboard access policy are granted the "get" verb on the rayclusters/dashboard
+	// subresource of the RayCluster, which the auth proxy checks with a SubjectAccessReview.
+	DefaultDashboardAccessVerb = "get"
+	DashboardAccessResource    = "rayclusters"
+	DashboardAccessSubresource = "dashboard"
+
 	// In KubeRay, the Ray container must be the first application container in a head or worker Pod.
 	RayContainerIndex = 0
 
@@ -79,6 +128,20 @@ const (
 	MetricsPortName   = "metrics"
 	ServingPortName   = "serve"
 
+	// The ports of the auth proxy listeners in front of the Ray Serve HTTP endpoint and the Ray client port
+	DefaultServeAuthProxyPort  = 8444
+	DefaultClientAuthProxyPort = 10443
+	ServeAuthProxyPortName     = "serve-proxy"
+	ClientAuthProxyPortName    = "client-proxy"
+
+	// The ports allowed by the egress NetworkPolicies. The DNS Pods of OpenShift listen on 5353, and the
+	// Kubernetes API ports are only used when the endpoints of the kubernetes Service cannot be read.
+	DefaultDNSPort           = 53
+	OpenShiftDNSPort         = 5353
+	DefaultKubernetesAPIPort = 443
+	KubernetesAPIServerPort  = 6443
+	DefaultRedisPort         = 6379
+
 	// The default AppProtocol for Kubernetes service
 	DefaultServiceAppProtocol = "tcp"
 
@@ -200,6 +263,12 @@ const (
 	RayServeProxyHealthPath   = "-/healthz"
 	BaseWgetHealthCommand     = "wget --tries 1 -T %d -q -O- http://localhost:%d/%s | grep success"
 
+	// Ray node drain related configurations
+	// The preemption reason is used so that the drain request is accepted even if the node is busy.
+	// The node stops accepting new tasks and exits once its running tasks and actors finish.
+	RayNodeDrainReasonPreemption = "DRAIN_NODE_REASON_PREEMPTION"
+	RayNodeStateAlive            = "ALIVE"
+
 	// Finalizers for RayJob
 	RayJobStopJobFinalizer = "ray.io/rayjob-finalizer"
 
@@ -287,6 +356,26 @@ const (
 	DeletedWorkerPod                  K8sEventType = "DeletedWorkerPod"
 	FailedToDeleteWorkerPod           K8sEventType = "FailedToDeleteWorkerPod"
 	FailedToDeleteWorkerPodCollection K8sEventType = "FailedToDeleteWorkerPodCollection"
+	DrainingWorkerPod                 K8sEventType = "DrainingWorkerPod"
+	FailedToDrainWorkerPod            K8sEventType = "FailedToDrainWorkerPod"
+
+	// Idle suspension event list
+	SuspendedIdleRayCluster       K8sEventType = "SuspendedIdleRayCluster"
+	FailedToSuspendIdleRayCluster K8sEventType = "FailedToSuspendIdleRayCluster"
+
+	// Suspend schedule event list
+	ScheduledSuspendRayCluster             K8sEventType = "ScheduledSuspendRayCluster"
+	ScheduledResumeRayCluster              K8sEventType = "ScheduledResumeRayCluster"
+	FailedToApplyScheduledRayClusterAction K8sEventType = "FailedToApplyScheduledRayClusterAction"
+
+	// Batch scheduler event list
+	BatchSchedulerNotEnabled K8sEventType = "BatchSchedulerNotEnabled"
+
+	// mTLS certificate event list
+	CertificatesRotated       K8sEventType = "CertificatesRotated"
+	IssuedCertificate         K8sEventType = "IssuedCertificate"
+	FailedToIssueCertificates K8sEventType = "FailedToIssueCertificates"
+	CertificateExpiringSoon   K8sEventType = "CertificateExpiringSoon"
 
 	// Redis Cleanup Job event list
 	CreatedRedisCleanupJob        K8sEventType = "CreatedRedisCleanupJob"
@@ -352,4 +441,16 @@ const (
 	// RoleBinding list
 	CreatedRoleBinding        K8sEventType = "CreatedRoleBinding"
 	FailedToCreateRoleBinding K8sEventType = "FailedToCreateRoleBinding"
+
+	// PodDisruptionBudget event list
+	CreatedPodDisruptionBudget        K8sEventType = "CreatedPodDisruptionBudget"
+	UpdatedPodDisruptionBudget        K8sEventType = "UpdatedPodDisruptionBudget"
+	DeletedPodDisruptionBudget        K8sEventType = "DeletedPodDisruptionBudget"
+	FailedToCreatePodDisruptionBudget K8sEventType = "FailedToCreatePodDisruptionBudget"
+	FailedToUpdatePodDisruptionBudget K8sEventType = "FailedToUpdatePodDisruptionBudget"
+	FailedToDeletePodDisruptionBudget K8sEventType = "FailedToDeletePodDisruptionBudget"
+
+	// PersistentVolumeClaim event list
+	CreatedPersistentVolumeClaim        K8sEventType = "CreatedPersistentVolumeClaim"
+	FailedToCreatePersistentVolumeClaim K8sEventType = "FailedToCreatePersistentVolumeClaim"
 )
@@ -25,6 +25,9 @@ var (
 	DeployPathV2     = "/api/serve/applications/"
 	// Job URL paths
 	JobPath = "/api/jobs/"
+	// Node URL paths
+	NodesPath     = "/api/v0/nodes"
+	DrainNodePath = "/api/v0/nodes/drain"
 )
 
 type RayDashboardClientInterface interface {
@@ -40,6 +43,9 @@ type RayDashboardClientInterface interface {
 	GetJobLog(ctx context.Context, jobName string) (*string, error)
 	StopJob(ctx context.Context, jobName string) error
 	DeleteJob(ctx context.Context, jobName string) error
+	// Node drain API
+	ListNodes(ctx context.Context) ([]RayNodeInfo, error)
+	DrainNode(ctx context.Context, nodeID string, reason string, reasonMessage string, deadline time.Time) error
 }
 
 type BaseDashboardClient struct {
@@ -446,6 +452,107 @@ func (r *RayDashboardClient) DeleteJob(ctx context.Context, jobName string) erro
 	return nil
 }
 
+// RayNodeInfo is a Ray node returned by the "ray list nodes" api.
+// Reference to https://docs.ray.io/en/latest/ray-observability/reference/doc/ray.util.state.common.NodeState.html
+type RayNodeInfo struct {
+	NodeID     string `json:"node_id"`
+	NodeIP     string `json:"node_ip"`
+	State      string `json:"state"`
+	IsHeadNode bool   `json:"is_head_node"`
+}
+
+type RayNodeListResponse struct {
+	Data struct {
+		Result struct {
+			Result []RayNodeInfo `json:"result"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// RayDrainNodeRequest is the request body to drain a Ray node. It mirrors the DrainNodeRequest of the GCS.
+// Reference to https://github.com/ray-project/ray/blob/ray-2.9.0/src/ray/protobuf/autoscaler.proto
+type RayDrainNodeRequest struct {
+	NodeID              string `json:"node_id"`
+	Reason              string `json:"reason"`
+	ReasonMessage       string `json:"reason_message,omitempty"`
+	DeadlineTimestampMs int64  `json:"deadline_timestamp_ms"`
+}
+
+type RayDrainNodeResponse struct {
+	RejectionReasonMessage string `json:"rejection_reason_message,omitempty"`
+	IsAccepted             bool   `json:"is_accepted"`
+}
+
+// ListNodes lists the Ray nodes of the cluster, including the nodes that are dead.
+func (r *RayDashboardClient) ListNodes(ctx context.Context) ([]RayNodeInfo, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.dashboardURL+NodesPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("ListNodes fail: %s %s", resp.Status, string(body))
+	}
+
+	var nodeList RayNodeListResponse
+	if err = json.Unmarshal(body, &nodeList); err != nil {
+		return nil, fmt.Errorf("ListNodes fail: %s", string(body))
+	}
+
+	return nodeList.Data.Result.Result, nil
+}
+
+// DrainNode asks the GCS to drain the Ray node. The node stops accepting new tasks and exits once it is idle.
+// It returns an error if the drain request is rejected.
+func (r *RayDashboardClient) DrainNode(ctx context.Context, nodeID string, reason string, reasonMessage string, deadline time.Time) error {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Drain a ray node", "nodeID", nodeID, "reason", reason, "deadline", deadline)
+
+	drainJson, err := json.Marshal(&RayDrainNodeRequest{
+		NodeID:              nodeID,
+		Reason:              reason,
+		ReasonMessage:       reasonMessage,
+		DeadlineTimestampMs: deadline.UnixMilli(),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.dashboardURL+DrainNodePath, bytes.NewBuffer(drainJson))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("DrainNode fail: %s %s", resp.Status, string(body))
+	}
+
+	var drainResp RayDrainNodeResponse
+	if err = json.Unmarshal(body, &drainResp); err != nil {
+		return fmt.Errorf("DrainNode fail: %s", string(body))
+	}
+	if !drainResp.IsAccepted {
+		return fmt.Errorf("DrainNode rejected: %s", drainResp.RejectionReasonMessage)
+	}
+
+	return nil
+}
+
 func ConvertRayJobToReq(rayJob *rayv1.RayJob) (*RayJobRequest, error) {
 	req := &RayJobRequest{
 		Entrypoint:   rayJob.Spec.Entrypoint,
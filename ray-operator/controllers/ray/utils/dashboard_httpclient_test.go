@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/jarcoal/httpmock"
 	. "github.com/onsi/ginkgo/v2"
@@ -171,4 +172,47 @@ var _ = Describe("RayFrameworkGenerator", func() {
 		err := rayDashboardClient.StopJob(context.TODO(), "stop-job-1")
 		Expect(err).ToNot(HaveOccurred())
 	})
+
+	It("Test list nodes", func() {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("GET", rayDashboardClient.dashboardURL+NodesPath,
+			func(_ *http.Request) (*http.Response, error) {
+				return httpmock.NewStringResponse(200, `{"result": true, "msg": "", "data": {"result": {"total": 2, "result": [
+					{"node_id": "head", "node_ip": "10.0.0.1", "state": "ALIVE", "is_head_node": true},
+					{"node_id": "worker", "node_ip": "10.0.0.2", "state": "ALIVE", "is_head_node": false}]}}}`), nil
+			})
+
+		nodes, err := rayDashboardClient.ListNodes(context.TODO())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(nodes).To(HaveLen(2))
+		Expect(nodes[1]).To(Equal(RayNodeInfo{NodeID: "worker", NodeIP: "10.0.0.2", State: RayNodeStateAlive}))
+	})
+
+	It("Test drain node", func() {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+		deadline := time.UnixMilli(1700000000000)
+		httpmock.RegisterResponder("POST", rayDashboardClient.dashboardURL+DrainNodePath,
+			func(req *http.Request) (*http.Response, error) {
+				var drainReq RayDrainNodeRequest
+				if err := json.NewDecoder(req.Body).Decode(&drainReq); err != nil {
+					return nil, err
+				}
+				body := &RayDrainNodeResponse{
+					IsAccepted:             drainReq.NodeID == "worker",
+					RejectionReasonMessage: "node not found",
+				}
+				Expect(drainReq.Reason).To(Equal(RayNodeDrainReasonPreemption))
+				Expect(drainReq.DeadlineTimestampMs).To(Equal(deadline.UnixMilli()))
+				bodyBytes, _ := json.Marshal(body)
+				return httpmock.NewBytesResponse(200, bodyBytes), nil
+			})
+
+		err := rayDashboardClient.DrainNode(context.TODO(), "worker", RayNodeDrainReasonPreemption, "scale down", deadline)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = rayDashboardClient.DrainNode(context.TODO(), "unknown", RayNodeDrainReasonPreemption, "scale down", deadline)
+		Expect(err).To(MatchError(ContainSubstring("DrainNode rejected: node not found")))
+	})
 })
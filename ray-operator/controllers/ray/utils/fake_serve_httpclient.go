@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"sync/atomic"
+	"time"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
 )
@@ -12,7 +13,9 @@ import (
 type FakeRayDashboardClient struct {
 	multiAppStatuses map[string]*ServeApplicationStatus
 	GetJobInfoMock   atomic.Pointer[func(context.Context, string) (*RayJobInfo, error)]
+	DrainNodeMock    atomic.Pointer[func(context.Context, string) error]
 	BaseDashboardClient
+	nodes        []RayNodeInfo
 	serveDetails ServeDetails
 }
 
@@ -79,3 +82,18 @@ func (r *FakeRayDashboardClient) StopJob(_ context.Context, _ string) (err error
 func (r *FakeRayDashboardClient) DeleteJob(_ context.Context, _ string) error {
 	return nil
 }
+
+func (r *FakeRayDashboardClient) ListNodes(_ context.Context) ([]RayNodeInfo, error) {
+	return r.nodes, nil
+}
+
+func (r *FakeRayDashboardClient) SetNodes(nodes []RayNodeInfo) {
+	r.nodes = nodes
+}
+
+func (r *FakeRayDashboardClient) DrainNode(ctx context.Context, nodeID string, _ string, _ string, _ time.Time) error {
+	if mock := r.DrainNodeMock.Load(); mock != nil {
+		return (*mock)(ctx, nodeID)
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package utils
+
+import (
+	"strings"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/utils/ptr"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+)
+
+// IsSecureTrustedNetworkAnnotationEnabled returns whether the odh.ray.io/secure-trusted-network annotation of the
+// RayCluster is set to a truthy value, i.e. "true", "1", "yes" or "on" (case-insensitive).
+func IsSecureTrustedNetworkAnnotationEnabled(cluster *rayv1.RayCluster) bool {
+	if cluster == nil || cluster.Annotations == nil {
+		return false
+	}
+	switch strings.ToLower(cluster.Annotations[EnableSecureTrustedNetworkAnnotationKey]) {
+	case "true", "1", "yes", "on":
+		return true
+	default:
+		return false
+	}
+}
+
+// isSecurityOptionEnabled returns the value of a toggle of the securityOptions of the RayCluster, or falls back
+// to the odh.ray.io/secure-trusted-network annotation if the toggle is not set.
+func isSecurityOptionEnabled(cluster *rayv1.RayCluster, option func(*rayv1.SecurityOptions) *bool) bool {
+	if cluster == nil {
+		return false
+	}
+	if cluster.Spec.SecurityOptions != nil {
+		if enabled := option(cluster.Spec.SecurityOptions); enabled != nil {
+			return *enabled
+		}
+	}
+	return IsSecureTrustedNetworkAnnotationEnabled(cluster)
+}
+
+// IsMTLSEnabled returns whether mutual TLS is enabled between the Pods of the RayCluster.
+func IsMTLSEnabled(cluster *rayv1.RayCluster) bool {
+	return isSecurityOptionEnabled(cluster, func(options *rayv1.SecurityOptions) *bool { return options.MTLS })
+}
+
+// IsNetworkPolicyEnabled returns whether NetworkPolicies are created for the RayCluster.
+func IsNetworkPolicyEnabled(cluster *rayv1.RayCluster) bool {
+	return isSecurityOptionEnabled(cluster, func(options *rayv1.SecurityOptions) *bool { return options.NetworkPolicy })
+}
+
+// IsAuthProxyEnabled returns whether the OAuth or OIDC proxy is deployed in front of the Ray dashboard of the RayCluster.
+func IsAuthProxyEnabled(cluster *rayv1.RayCluster) bool {
+	return isSecurityOptionEnabled(cluster, func(options *rayv1.SecurityOptions) *bool { return options.AuthProxy })
+}
+
+// IsServeAuthProxyEnabled returns whether the Ray Serve HTTP endpoint of the RayCluster is behind the auth proxy.
+func IsServeAuthProxyEnabled(cluster *rayv1.RayCluster) bool {
+	return IsAuthProxyEnabled(cluster) && cluster.Spec.SecurityOptions != nil &&
+		ptr.Deref(cluster.Spec.SecurityOptions.ServeAuthProxy, false)
+}
+
+// IsClientAuthProxyEnabled returns whether the Ray client port of the RayCluster is exposed through the auth proxy.
+func IsClientAuthProxyEnabled(cluster *rayv1.RayCluster) bool {
+	return IsAuthProxyEnabled(cluster) && cluster.Spec.SecurityOptions != nil &&
+		ptr.Deref(cluster.Spec.SecurityOptions.ClientAuthProxy, false)
+}
+
+// IsDNSCertificateSANsEnabled returns whether the mTLS certificates of the RayCluster list the DNS names of the
+// Ray services instead of the IPs of the Ray Pods.
+func IsDNSCertificateSANsEnabled(cluster *rayv1.RayCluster) bool {
+	if !IsMTLSEnabled(cluster) || cluster.Spec.SecurityOptions == nil || cluster.Spec.SecurityOptions.CertificateSANs == nil {
+		return false
+	}
+	return *cluster.Spec.SecurityOptions.CertificateSANs == rayv1.DNSCertificateSANs
+}
+
+// GetCertificateIssuer returns the issuer that signs the mTLS certificates of the RayCluster, i.e. the issuer of its
+// security options, else the default issuer of the operator. It returns nil if the RayCluster bootstraps its own CA.
+func GetCertificateIssuer(cluster *rayv1.RayCluster, defaultIssuer *rayv1.CertificateIssuer) *rayv1.CertificateIssuer {
+	if cluster != nil && cluster.Spec.SecurityOptions != nil && cluster.Spec.SecurityOptions.CertificateIssuer != nil {
+		return cluster.Spec.SecurityOptions.CertificateIssuer
+	}
+	return defaultIssuer
+}
+
+// GetCertificateIssuerKindAndGroup returns the kind and group of a certificate issuer, or their cert-manager
+// defaults if they are not set.
+func GetCertificateIssuerKindAndGroup(issuer *rayv1.CertificateIssuer) (string, string) {
+	kind, group := issuer.Kind, issuer.Group
+	if kind == "" {
+		kind = DefaultCertificateIssuerKind
+	}
+	if group == "" {
+		group = DefaultCertificateIssuerGroup
+	}
+	return kind, group
+}
+
+// GetDashboardAccessPolicy returns the dashboard access policy of the RayCluster, or nil if it has none.
+func GetDashboardAccessPolicy(cluster *rayv1.RayCluster) *rayv1.DashboardAccessPolicy {
+	if cluster == nil || cluster.Spec.SecurityOptions == nil {
+		return nil
+	}
+	return cluster.Spec.SecurityOptions.DashboardAccessPolicy
+}
+
+// HasDashboardAccessSubjects returns whether the dashboard access policy of the RayCluster lists users or groups.
+func HasDashboardAccessSubjects(cluster *rayv1.RayCluster) bool {
+	policy := GetDashboardAccessPolicy(cluster)
+	return policy != nil && (len(policy.Users) > 0 || len(policy.Groups) > 0)
+}
+
+// GetDashboardAccessAttributes returns the attributes of the SubjectAccessReview that the auth proxy checks before
+// forwarding a request to the Ray dashboard. Users and groups are checked against the rayclusters/dashboard
+// subresource of the RayCluster. It returns nil if the RayCluster has no dashboard access policy, in which case the
+// auth proxy keeps its default check.
+func GetDashboardAccessAttributes(cluster *rayv1.RayCluster) *authorizationv1.ResourceAttributes {
+	policy := GetDashboardAccessPolicy(cluster)
+	if policy == nil {
+		return nil
+	}
+	if custom := policy.ResourceAttributes; custom != nil {
+		verb := custom.Verb
+		if verb == "" {
+			verb = DefaultDashboardAccessVerb
+		}
+		return &authorizationv1.ResourceAttributes{
+			Namespace:   cluster.Namespace,
+			Verb:        verb,
+			Group:       custom.Group,
+			Resource:    custom.Resource,
+			Subresource: custom.Subresource,
+			Name:        custom.Name,
+		}
+	}
+	if !HasDashboardAccessSubjects(cluster) {
+		return nil
+	}
+	return &authorizationv1.ResourceAttributes{
+		Namespace:   cluster.Namespace,
+		Verb:        DefaultDashboardAccessVerb,
+		Group:       rayv1.GroupVersion.Group,
+		Resource:    DashboardAccessResource,
+		Subresource: DashboardAccessSubresource,
+		Name:        cluster.Name,
+	}
+}
+
+// GetSecurityStatus returns the effective security settings of the RayCluster.
+func GetSecurityStatus(cluster *rayv1.RayCluster, authMode AuthenticationMode) *rayv1.SecurityStatus {
+	status := &rayv1.SecurityStatus{
+		MTLSEnabled:          IsMTLSEnabled(cluster),
+		NetworkPolicyEnabled: IsNetworkPolicyEnabled(cluster),
+		AuthProxyEnabled:     IsAuthProxyEnabled(cluster),
+	}
+	if status.AuthProxyEnabled {
+		status.AuthenticationMode = string(authMode)
+	}
+	return status
+}
@@ -0,0 +1,168 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+)
+
+func TestIsSecureTrustedNetworkAnnotationEnabled(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected bool
+	}{
+		{"true", true},
+		{"True", true},
+		{"1", true},
+		{"yes", true},
+		{"ON", true},
+		{"false", false},
+		{"0", false},
+		{"no", false},
+		{"", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.value, func(t *testing.T) {
+			cluster := &rayv1.RayCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{EnableSecureTrustedNetworkAnnotationKey: tc.value},
+				},
+			}
+			assert.Equal(t, tc.expected, IsSecureTrustedNetworkAnnotationEnabled(cluster))
+		})
+	}
+	assert.False(t, IsSecureTrustedNetworkAnnotationEnabled(nil))
+	assert.False(t, IsSecureTrustedNetworkAnnotationEnabled(&rayv1.RayCluster{}))
+}
+
+func TestSecurityOptionsOverrideAnnotation(t *testing.T) {
+	cluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{EnableSecureTrustedNetworkAnnotationKey: "yes"},
+		},
+	}
+	// Without security options, every component follows the annotation.
+	assert.True(t, IsMTLSEnabled(cluster))
+	assert.True(t, IsNetworkPolicyEnabled(cluster))
+	assert.True(t, IsAuthProxyEnabled(cluster))
+	assert.True(t, ShouldEnableOAuth(cluster, ModeIntegratedOAuth))
+
+	// Toggles that are set take precedence over the annotation.
+	cluster.Spec.SecurityOptions = &rayv1.SecurityOptions{MTLS: ptr.To(false), AuthProxy: ptr.To(false)}
+	assert.False(t, IsMTLSEnabled(cluster))
+	assert.True(t, IsNetworkPolicyEnabled(cluster))
+	assert.False(t, IsAuthProxyEnabled(cluster))
+	assert.False(t, ShouldEnableOAuth(cluster, ModeIntegratedOAuth))
+
+	cluster.Annotations = nil
+	cluster.Spec.SecurityOptions = &rayv1.SecurityOptions{MTLS: ptr.To(true)}
+	assert.True(t, IsMTLSEnabled(cluster))
+	assert.False(t, IsNetworkPolicyEnabled(cluster))
+	assert.False(t, IsAuthProxyEnabled(cluster))
+}
+
+func TestIsServeAndClientAuthProxyEnabled(t *testing.T) {
+	cluster := &rayv1.RayCluster{}
+	assert.False(t, IsServeAuthProxyEnabled(cluster))
+	assert.False(t, IsClientAuthProxyEnabled(cluster))
+
+	cluster.Spec.SecurityOptions = &rayv1.SecurityOptions{ServeAuthProxy: ptr.To(true), ClientAuthProxy: ptr.To(true)}
+	// The listeners require the auth proxy.
+	assert.False(t, IsServeAuthProxyEnabled(cluster))
+	assert.False(t, IsClientAuthProxyEnabled(cluster))
+
+	cluster.Spec.SecurityOptions.AuthProxy = ptr.To(true)
+	assert.True(t, IsServeAuthProxyEnabled(cluster))
+	assert.True(t, IsClientAuthProxyEnabled(cluster))
+
+	cluster.Spec.SecurityOptions.ClientAuthProxy = ptr.To(false)
+	assert.False(t, IsClientAuthProxyEnabled(cluster))
+}
+
+func TestIsDNSCertificateSANsEnabled(t *testing.T) {
+	cluster := &rayv1.RayCluster{}
+	assert.False(t, IsDNSCertificateSANsEnabled(cluster))
+
+	cluster.Spec.SecurityOptions = &rayv1.SecurityOptions{MTLS: ptr.To(true)}
+	assert.False(t, IsDNSCertificateSANsEnabled(cluster))
+
+	cluster.Spec.SecurityOptions.CertificateSANs = ptr.To(rayv1.DNSCertificateSANs)
+	assert.True(t, IsDNSCertificateSANsEnabled(cluster))
+
+	// DNS SANs only apply when mTLS is enabled.
+	cluster.Spec.SecurityOptions.MTLS = ptr.To(false)
+	assert.False(t, IsDNSCertificateSANsEnabled(cluster))
+}
+
+func TestGetCertificateIssuer(t *testing.T) {
+	defaultIssuer := &rayv1.CertificateIssuer{Name: "corporate-ca", Kind: "ClusterIssuer"}
+	cluster := &rayv1.RayCluster{}
+	assert.Nil(t, GetCertificateIssuer(cluster, nil))
+	assert.Equal(t, defaultIssuer, GetCertificateIssuer(cluster, defaultIssuer))
+
+	// The issuer of the RayCluster overrides the default issuer.
+	clusterIssuer := &rayv1.CertificateIssuer{Name: "shared-ca"}
+	cluster.Spec.SecurityOptions = &rayv1.SecurityOptions{CertificateIssuer: clusterIssuer}
+	assert.Equal(t, clusterIssuer, GetCertificateIssuer(cluster, defaultIssuer))
+
+	kind, group := GetCertificateIssuerKindAndGroup(clusterIssuer)
+	assert.Equal(t, DefaultCertificateIssuerKind, kind)
+	assert.Equal(t, DefaultCertificateIssuerGroup, group)
+}
+
+func TestGetDashboardAccessAttributes(t *testing.T) {
+	cluster := &rayv1.RayCluster{ObjectMeta: metav1.ObjectMeta{Name: "raycluster", Namespace: "ml"}}
+	assert.Nil(t, GetDashboardAccessAttributes(cluster))
+	assert.False(t, HasDashboardAccessSubjects(cluster))
+
+	// An empty policy keeps the default check of the auth proxy.
+	cluster.Spec.SecurityOptions = &rayv1.SecurityOptions{DashboardAccessPolicy: &rayv1.DashboardAccessPolicy{}}
+	assert.Nil(t, GetDashboardAccessAttributes(cluster))
+
+	// Users and groups are checked against the dashboard subresource of the RayCluster.
+	cluster.Spec.SecurityOptions.DashboardAccessPolicy.Groups = []string{"ml-team"}
+	assert.True(t, HasDashboardAccessSubjects(cluster))
+	assert.Equal(t, &authorizationv1.ResourceAttributes{
+		Namespace:   "ml",
+		Verb:        "get",
+		Group:       "ray.io",
+		Resource:    "rayclusters",
+		Subresource: "dashboard",
+		Name:        "raycluster",
+	}, GetDashboardAccessAttributes(cluster))
+
+	// Custom resource attributes default to the "get" verb.
+	cluster.Spec.SecurityOptions.DashboardAccessPolicy = &rayv1.DashboardAccessPolicy{
+		ResourceAttributes: &rayv1.DashboardResourceAttributes{Resource: "services", Name: "raycluster-head-svc"},
+	}
+	assert.False(t, HasDashboardAccessSubjects(cluster))
+	assert.Equal(t, &authorizationv1.ResourceAttributes{
+		Namespace: "ml",
+		Verb:      "get",
+		Resource:  "services",
+		Name:      "raycluster-head-svc",
+	}, GetDashboardAccessAttributes(cluster))
+}
+
+func TestGetSecurityStatus(t *testing.T) {
+	cluster := &rayv1.RayCluster{
+		Spec: rayv1.RayClusterSpec{
+			SecurityOptions: &rayv1.SecurityOptions{NetworkPolicy: ptr.To(true), AuthProxy: ptr.To(true)},
+		},
+	}
+	assert.Equal(t, &rayv1.SecurityStatus{
+		NetworkPolicyEnabled: true,
+		AuthProxyEnabled:     true,
+		AuthenticationMode:   string(ModeOIDC),
+	}, GetSecurityStatus(cluster, ModeOIDC))
+
+	// The authentication mode is only reported when the auth proxy is enabled.
+	cluster.Spec.SecurityOptions.AuthProxy = ptr.To(false)
+	assert.Equal(t, &rayv1.SecurityStatus{NetworkPolicyEnabled: true}, GetSecurityStatus(cluster, ModeOIDC))
+}
@@ -8,14 +8,18 @@ import (
 	"math"
 	"os"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 	"unicode"
 
+	"github.com/robfig/cron/v3"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/json"
 	"k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/client-go/discovery"
@@ -100,6 +104,151 @@ func FindHeadPodReadyCondition(headPod *corev1.Pod) metav1.Condition {
 	return headPodReadyCondition
 }
 
+// FindWorkerGroupsUpdatingCondition returns the WorkerGroupsUpdating condition of the RayCluster. The second return
+// value is false if none of the worker groups has an update strategy other than OnDelete.
+func FindWorkerGroupsUpdatingCondition(cluster *rayv1.RayCluster, pods corev1.PodList) (metav1.Condition, bool) {
+	var updatingGroups []string
+	hasUpdateStrategy := false
+	for _, nodeGroup := range cluster.Spec.WorkerGroupSpecs {
+		if GetWorkerGroupUpdateStrategyType(nodeGroup) == rayv1.OnDeleteUpdateStrategy {
+			continue
+		}
+		hasUpdateStrategy = true
+		templateHash, err := GenerateWorkerGroupTemplateHash(nodeGroup)
+		if err != nil {
+			continue
+		}
+		for _, pod := range pods.Items {
+			if pod.Labels[RayNodeTypeLabelKey] == string(rayv1.WorkerNode) &&
+				pod.Labels[RayNodeGroupLabelKey] == nodeGroup.GroupName &&
+				IsWorkerPodOutdated(pod, templateHash) {
+				updatingGroups = append(updatingGroups, nodeGroup.GroupName)
+				break
+			}
+		}
+	}
+	if !hasUpdateStrategy {
+		return metav1.Condition{}, false
+	}
+	if len(updatingGroups) > 0 {
+		return metav1.Condition{
+			Type:    string(rayv1.RayClusterWorkerGroupsUpdating),
+			Status:  metav1.ConditionTrue,
+			Reason:  rayv1.WorkerPodsOutdated,
+			Message: fmt.Sprintf("Worker groups with outdated Pods: %s", strings.Join(updatingGroups, ", ")),
+		}, true
+	}
+	return metav1.Condition{
+		Type:   string(rayv1.RayClusterWorkerGroupsUpdating),
+		Status: metav1.ConditionFalse,
+		Reason: rayv1.AllWorkerPodsUpdated,
+	}, true
+}
+
+// FindWorkersUnschedulableCondition returns the WorkersUnschedulable condition of the RayCluster. The second return
+// value is false if all worker Pods can be scheduled.
+func FindWorkersUnschedulableCondition(pods corev1.PodList) (metav1.Condition, bool) {
+	counts := countPodsByGroup(pods, func(pod corev1.Pod) bool {
+		return pod.Labels[RayNodeTypeLabelKey] == string(rayv1.WorkerNode) && isPodUnschedulable(pod)
+	})
+	if len(counts) == 0 {
+		return metav1.Condition{}, false
+	}
+	return metav1.Condition{
+		Type:    string(rayv1.RayClusterWorkersUnschedulable),
+		Status:  metav1.ConditionTrue,
+		Reason:  rayv1.WorkerPodsUnschedulable,
+		Message: "Unschedulable worker Pods by group: " + formatPodCountsByGroup(counts),
+	}, true
+}
+
+// FindImagePullFailureCondition returns the ImagePullFailure condition of the RayCluster. The second return
+// value is false if no Ray Pod fails to pull its container images.
+func FindImagePullFailureCondition(pods corev1.PodList) (metav1.Condition, bool) {
+	counts := countPodsByGroup(pods, hasImagePullFailure)
+	if len(counts) == 0 {
+		return metav1.Condition{}, false
+	}
+	return metav1.Condition{
+		Type:    string(rayv1.RayClusterImagePullFailure),
+		Status:  metav1.ConditionTrue,
+		Reason:  rayv1.RayPodsImagePullFailed,
+		Message: "Pods failing to pull images by group: " + formatPodCountsByGroup(counts),
+	}, true
+}
+
+// FindHeadCrashLoopingCondition returns the HeadCrashLooping condition of the RayCluster. The second return
+// value is false if the head Pod is nil or none of its containers is in CrashLoopBackOff.
+func FindHeadCrashLoopingCondition(headPod *corev1.Pod) (metav1.Condition, bool) {
+	if headPod == nil {
+		return metav1.Condition{}, false
+	}
+	for _, statuses := range [][]corev1.ContainerStatus{headPod.Status.InitContainerStatuses, headPod.Status.ContainerStatuses} {
+		for _, containerStatus := range statuses {
+			if waiting := containerStatus.State.Waiting; waiting != nil && waiting.Reason == "CrashLoopBackOff" {
+				return metav1.Condition{
+					Type:   string(rayv1.RayClusterHeadCrashLooping),
+					Status: metav1.ConditionTrue,
+					Reason: rayv1.HeadPodCrashLoopBackOff,
+					Message: fmt.Sprintf("Container %s of head Pod %s is in CrashLoopBackOff after %d restarts: %s",
+						containerStatus.Name, headPod.Name, containerStatus.RestartCount, waiting.Message),
+				}, true
+			}
+		}
+	}
+	return metav1.Condition{}, false
+}
+
+// countPodsByGroup counts the Pods that are not being deleted and satisfy the predicate by their ray.io/group label.
+func countPodsByGroup(pods corev1.PodList, predicate func(pod corev1.Pod) bool) map[string]int {
+	counts := map[string]int{}
+	for _, pod := range pods.Items {
+		if pod.DeletionTimestamp != nil || !predicate(pod) {
+			continue
+		}
+		counts[pod.Labels[RayNodeGroupLabelKey]]++
+	}
+	return counts
+}
+
+// formatPodCountsByGroup formats the Pod counts sorted by group name, e.g. "cpu-group (1), gpu-group (2)".
+func formatPodCountsByGroup(counts map[string]int) string {
+	groups := make([]string, 0, len(counts))
+	for group := range counts {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+	for i, group := range groups {
+		groups[i] = fmt.Sprintf("%s (%d)", group, counts[group])
+	}
+	return strings.Join(groups, ", ")
+}
+
+// isPodUnschedulable returns true if the scheduler failed to find a node for the Pod.
+func isPodUnschedulable(pod corev1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodScheduled && cond.Status == corev1.ConditionFalse && cond.Reason == corev1.PodReasonUnschedulable {
+			return true
+		}
+	}
+	return false
+}
+
+// hasImagePullFailure returns true if any container of the Pod fails to pull its image.
+func hasImagePullFailure(pod corev1.Pod) bool {
+	for _, statuses := range [][]corev1.ContainerStatus{pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses} {
+		for _, containerStatus := range statuses {
+			if waiting := containerStatus.State.Waiting; waiting != nil {
+				switch waiting.Reason {
+				case "ErrImagePull", "ImagePullBackOff", "InvalidImageName", "ErrImageNeverPull":
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
 // FindRayClusterSuspendStatus returns the current suspend status from two conditions:
 //  1. rayv1.RayClusterSuspending
 //  2. rayv1.RayClusterSuspended
@@ -279,6 +428,11 @@ func GenerateHeadServiceName(crdType CRDType, clusterSpec rayv1.RayClusterSpec,
 	}
 }
 
+// GenerateHeadlessServiceName generates the name of the headless worker service of a RayCluster.
+func GenerateHeadlessServiceName(clusterName string) string {
+	return clusterName + DashSymbol + HeadlessServiceSuffix
+}
+
 // GenerateFQDNServiceName generates a Fully Qualified Domain Name.
 func GenerateFQDNServiceName(ctx context.Context, cluster rayv1.RayCluster, namespace string) string {
 	log := ctrl.LoggerFrom(ctx)
@@ -331,6 +485,52 @@ func GenerateIdentifier(clusterName string, nodeType rayv1.RayNodeType) string {
 	return fmt.Sprintf("%s-%s", clusterName, nodeType)
 }
 
+// GetWorkerReplicaIndex returns the index of the multi-host replica that the worker Pod belongs to. Pods of
+// single-host worker groups and Pods created before replicas were labeled don't have a replica index.
+func GetWorkerReplicaIndex(pod corev1.Pod) (int, bool) {
+	value, ok := pod.Labels[RayWorkerReplicaIndexKey]
+	if !ok {
+		return 0, false
+	}
+	index, err := strconv.Atoi(value)
+	if err != nil || index < 0 {
+		return 0, false
+	}
+	return index, true
+}
+
+// GroupPodsByReplicaIndex groups the Pods of a multi-host worker group by replica index. The Pods without a
+// replica index are returned separately.
+func GroupPodsByReplicaIndex(pods []corev1.Pod) (map[int][]corev1.Pod, []corev1.Pod) {
+	replicas := make(map[int][]corev1.Pod)
+	var unindexed []corev1.Pod
+	for _, pod := range pods {
+		if index, ok := GetWorkerReplicaIndex(pod); ok {
+			replicas[index] = append(replicas[index], pod)
+		} else {
+			unindexed = append(unindexed, pod)
+		}
+	}
+	return replicas, unindexed
+}
+
+// GetFreeReplicaIndices returns the lowest n replica indices that are not used by any of the Pods.
+func GetFreeReplicaIndices(pods []corev1.Pod, n int) []int {
+	used := make(map[int]bool, len(pods))
+	for _, pod := range pods {
+		if index, ok := GetWorkerReplicaIndex(pod); ok {
+			used[index] = true
+		}
+	}
+	indices := make([]int, 0, n)
+	for index := 0; len(indices) < n; index++ {
+		if !used[index] {
+			indices = append(indices, index)
+		}
+	}
+	return indices
+}
+
 func GetWorkerGroupDesiredReplicas(ctx context.Context, workerGroupSpec rayv1.WorkerGroupSpec) int32 {
 	log := ctrl.LoggerFrom(ctx)
 	// Always adhere to min/max replicas constraints.
@@ -354,6 +554,119 @@ func GetWorkerGroupDesiredReplicas(ctx context.Context, workerGroupSpec rayv1.Wo
 	return workerReplicas * workerGroupSpec.NumOfHosts
 }
 
+// GetWorkerGroupUpdateStrategyType returns the update strategy type of the worker group. It defaults to OnDelete.
+func GetWorkerGroupUpdateStrategyType(workerGroupSpec rayv1.WorkerGroupSpec) rayv1.WorkerGroupUpdateStrategyType {
+	if workerGroupSpec.UpdateStrategy == nil || workerGroupSpec.UpdateStrategy.Type == nil {
+		return rayv1.OnDeleteUpdateStrategy
+	}
+	return *workerGroupSpec.UpdateStrategy.Type
+}
+
+// GetWorkerGroupRollingUpdateBounds resolves maxSurge and maxUnavailable of the worker group's rolling update
+// against the desired number of worker Pods. Both default to 25%, like the rolling update of a Deployment.
+func GetWorkerGroupRollingUpdateBounds(workerGroupSpec rayv1.WorkerGroupSpec, desiredPods int) (maxSurge int, maxUnavailable int, err error) {
+	defaultValue := intstr.FromString("25%")
+	surge, unavailable := &defaultValue, &defaultValue
+	if workerGroupSpec.UpdateStrategy != nil && workerGroupSpec.UpdateStrategy.RollingUpdate != nil {
+		if workerGroupSpec.UpdateStrategy.RollingUpdate.MaxSurge != nil {
+			surge = workerGroupSpec.UpdateStrategy.RollingUpdate.MaxSurge
+		}
+		if workerGroupSpec.UpdateStrategy.RollingUpdate.MaxUnavailable != nil {
+			unavailable = workerGroupSpec.UpdateStrategy.RollingUpdate.MaxUnavailable
+		}
+	}
+	if maxSurge, err = intstr.GetScaledValueFromIntOrPercent(surge, desiredPods, true); err != nil {
+		return 0, 0, err
+	}
+	if maxUnavailable, err = intstr.GetScaledValueFromIntOrPercent(unavailable, desiredPods, false); err != nil {
+		return 0, 0, err
+	}
+	// Make progress even if both values are rounded down to 0.
+	if maxSurge == 0 && maxUnavailable == 0 {
+		maxUnavailable = 1
+	}
+	return maxSurge, maxUnavailable, nil
+}
+
+// GenerateWorkerGroupTemplateHash returns a hash of the worker group fields that are used to build worker Pods.
+// It is stored in the RayWorkerGroupTemplateHashKey annotation of each worker Pod to detect outdated Pods.
+func GenerateWorkerGroupTemplateHash(workerGroupSpec rayv1.WorkerGroupSpec) (string, error) {
+	return GenerateJsonHash(struct {
+		RayStartParams map[string]string `json:",omitempty"`
+		Template       corev1.PodTemplateSpec
+	}{
+		RayStartParams: workerGroupSpec.RayStartParams,
+		Template:       workerGroupSpec.Template,
+	})
+}
+
+// IsWorkerPodOutdated returns whether the worker Pod was created from a template other than the one identified by templateHash.
+// A Pod without the template hash annotation, e.g. created before the update strategy was enabled, is considered up to date
+// so that enabling an update strategy doesn't restart all the existing workers. The annotation is backfilled by the RayCluster
+// controller to detect later template changes.
+func IsWorkerPodOutdated(pod corev1.Pod, templateHash string) bool {
+	podTemplateHash, ok := pod.Annotations[RayWorkerGroupTemplateHashKey]
+	return ok && podTemplateHash != templateHash
+}
+
+// GenerateDisruptionBudgetName generates the name of the PodDisruptionBudget for a group of a RayCluster.
+func GenerateDisruptionBudgetName(clusterName string, groupName string) string {
+	return fmt.Sprintf("%s-%s-%s", clusterName, groupName, "pdb")
+}
+
+// GenerateVolumeClaimName generates the name of the PersistentVolumeClaim created from a volumeClaimTemplate for the
+// Pods of a group with the given volume claim index.
+func GenerateVolumeClaimName(templateName string, clusterName string, groupName string, index int) string {
+	return fmt.Sprintf("%s-%s-%s-%d", templateName, clusterName, groupName, index)
+}
+
+// GetVolumeClaimRetentionPolicy returns the retention policies of the PersistentVolumeClaims created from volumeClaimTemplates
+// when a Pod is deleted and when the RayCluster is deleted. Both default to Delete.
+func GetVolumeClaimRetentionPolicy(policy *rayv1.VolumeClaimRetentionPolicy) (whenPodDeleted rayv1.VolumeClaimRetentionPolicyType, whenClusterDeleted rayv1.VolumeClaimRetentionPolicyType) {
+	whenPodDeleted, whenClusterDeleted = rayv1.DeleteVolumeClaimRetentionPolicy, rayv1.DeleteVolumeClaimRetentionPolicy
+	if policy != nil && policy.WhenPodDeleted != nil {
+		whenPodDeleted = *policy.WhenPodDeleted
+	}
+	if policy != nil && policy.WhenClusterDeleted != nil {
+		whenClusterDeleted = *policy.WhenClusterDeleted
+	}
+	return whenPodDeleted, whenClusterDeleted
+}
+
+// GetDisruptionBudgetMaxUnavailableReplicas resolves the number of replicas of a group that may be disrupted
+// at the same time. It defaults to 1 and is always within [0, replicas].
+func GetDisruptionBudgetMaxUnavailableReplicas(budget rayv1.DisruptionBudget, replicas int32) (int32, error) {
+	var maxUnavailable int
+	switch {
+	case budget.MinAvailable != nil:
+		minAvailable, err := intstr.GetScaledValueFromIntOrPercent(budget.MinAvailable, int(replicas), true)
+		if err != nil {
+			return 0, err
+		}
+		maxUnavailable = int(replicas) - minAvailable
+	case budget.MaxUnavailable != nil:
+		var err error
+		if maxUnavailable, err = intstr.GetScaledValueFromIntOrPercent(budget.MaxUnavailable, int(replicas), true); err != nil {
+			return 0, err
+		}
+	default:
+		maxUnavailable = 1
+	}
+	return int32(min(max(maxUnavailable, 0), int(replicas))), nil //nolint:gosec // maxUnavailable is bounded by replicas
+}
+
+// CalculateDisruptionBudgetMinAvailablePods returns the number of Pods that the PodDisruptionBudget of a group must
+// keep available. Evicting any host of a multi-host replica disrupts the whole replica, so only as many Pods as
+// disruptable replicas are allowed to be evicted. An absolute minAvailable is used because RayCluster does not
+// implement the scale subresource, which the disruption controller requires to resolve maxUnavailable and percentages.
+func CalculateDisruptionBudgetMinAvailablePods(budget rayv1.DisruptionBudget, replicas int32, numOfHosts int32) (int32, error) {
+	maxUnavailable, err := GetDisruptionBudgetMaxUnavailableReplicas(budget, replicas)
+	if err != nil {
+		return 0, err
+	}
+	return replicas*numOfHosts - maxUnavailable, nil
+}
+
 // CalculateDesiredReplicas calculate desired worker replicas at the cluster level
 func CalculateDesiredReplicas(ctx context.Context, cluster *rayv1.RayCluster) int32 {
 	count := int32(0)
@@ -406,6 +719,59 @@ func CalculateReadyReplicas(pods corev1.PodList) int32 {
 	return count
 }
 
+// CalculateUpdatedReplicas calculates the number of worker Pods created from the current template of their worker group
+func CalculateUpdatedReplicas(cluster *rayv1.RayCluster, pods corev1.PodList) int32 {
+	templateHashes := make(map[string]string, len(cluster.Spec.WorkerGroupSpecs))
+	for _, nodeGroup := range cluster.Spec.WorkerGroupSpecs {
+		hash, err := GenerateWorkerGroupTemplateHash(nodeGroup)
+		if err != nil {
+			continue
+		}
+		templateHashes[nodeGroup.GroupName] = hash
+	}
+	count := int32(0)
+	for _, pod := range pods.Items {
+		if val, ok := pod.Labels[RayNodeTypeLabelKey]; !ok || val != string(rayv1.WorkerNode) {
+			continue
+		}
+		if hash, ok := templateHashes[pod.Labels[RayNodeGroupLabelKey]]; ok && !IsWorkerPodOutdated(pod, hash) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// GetWorkerPodDrainStartTime returns the time at which the drain of the worker Pod's Ray node was requested,
+// and whether the Ray node is being drained.
+func GetWorkerPodDrainStartTime(pod corev1.Pod) (time.Time, bool) {
+	value, ok := pod.Annotations[RayWorkerDrainStartTimeKey]
+	if !ok {
+		return time.Time{}, false
+	}
+	startTime, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		// Treat an invalid value as a drain that has already timed out.
+		return time.Time{}, true
+	}
+	return startTime, true
+}
+
+// CalculateDrainingReplicas calculates the number of worker Pods whose Ray nodes are being drained at the cluster level
+func CalculateDrainingReplicas(pods corev1.PodList) int32 {
+	count := int32(0)
+	for _, pod := range pods.Items {
+		if val, ok := pod.Labels[RayNodeTypeLabelKey]; !ok || val != string(rayv1.WorkerNode) {
+			continue
+		}
+		if _, draining := GetWorkerPodDrainStartTime(pod); draining && pod.DeletionTimestamp == nil {
+			count++
+		}
+	}
+
+	return count
+}
+
 // CalculateAvailableReplicas calculates available worker replicas at the cluster level
 // A worker is available if its Pod is running
 func CalculateAvailableReplicas(pods corev1.PodList) int32 {
@@ -422,6 +788,97 @@ func CalculateAvailableReplicas(pods corev1.PodList) int32 {
 	return count
 }
 
+// CalculateWorkerGroupStatuses calculates the observed state of each worker group in the cluster
+func CalculateWorkerGroupStatuses(ctx context.Context, cluster *rayv1.RayCluster, pods corev1.PodList) []rayv1.WorkerGroupStatus {
+	if len(cluster.Spec.WorkerGroupSpecs) == 0 {
+		return nil
+	}
+	statuses := make([]rayv1.WorkerGroupStatus, 0, len(cluster.Spec.WorkerGroupSpecs))
+	indexes := make(map[string]int, len(cluster.Spec.WorkerGroupSpecs))
+	for _, nodeGroup := range cluster.Spec.WorkerGroupSpecs {
+		indexes[nodeGroup.GroupName] = len(statuses)
+		statuses = append(statuses, rayv1.WorkerGroupStatus{
+			GroupName:       nodeGroup.GroupName,
+			DesiredReplicas: GetWorkerGroupDesiredReplicas(ctx, nodeGroup),
+		})
+	}
+
+	lastFailureTimes := make([]time.Time, len(statuses))
+	readyHostsPerReplica := make([]map[int]int32, len(statuses))
+	for _, pod := range pods.Items {
+		if val, ok := pod.Labels[RayNodeTypeLabelKey]; !ok || val != string(rayv1.WorkerNode) {
+			continue
+		}
+		i, ok := indexes[pod.Labels[RayNodeGroupLabelKey]]
+		if !ok {
+			continue
+		}
+		status := &statuses[i]
+		switch pod.Status.Phase {
+		case corev1.PodRunning:
+			status.AvailableReplicas++
+			if IsRunningAndReady(&pod) {
+				status.ReadyReplicas++
+				if index, ok := GetWorkerReplicaIndex(pod); ok {
+					if readyHostsPerReplica[i] == nil {
+						readyHostsPerReplica[i] = make(map[int]int32)
+					}
+					readyHostsPerReplica[i][index]++
+				}
+			}
+		case corev1.PodPending:
+			status.PendingReplicas++
+		case corev1.PodFailed:
+			status.FailedReplicas++
+		}
+		if reason := GetWorkerPodFailureReason(pod); reason != "" {
+			if status.LastFailureReason == "" || pod.CreationTimestamp.After(lastFailureTimes[i]) {
+				status.LastFailureReason = reason
+				lastFailureTimes[i] = pod.CreationTimestamp.Time
+			}
+		}
+	}
+
+	for i, nodeGroup := range cluster.Spec.WorkerGroupSpecs {
+		if nodeGroup.NumOfHosts <= 1 {
+			continue
+		}
+		for _, readyHosts := range readyHostsPerReplica[i] {
+			if readyHosts >= nodeGroup.NumOfHosts {
+				statuses[i].ReadyMultiHostReplicas++
+			}
+		}
+	}
+
+	return statuses
+}
+
+// GetWorkerPodFailureReason returns why the worker Pod is failing, or an empty string if it isn't.
+func GetWorkerPodFailureReason(pod corev1.Pod) string {
+	if pod.Status.Phase == corev1.PodFailed {
+		if pod.Status.Reason != "" {
+			return pod.Status.Reason
+		}
+		return string(corev1.PodFailed)
+	}
+	if isPodUnschedulable(pod) {
+		return corev1.PodReasonUnschedulable
+	}
+	for _, statuses := range [][]corev1.ContainerStatus{pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses} {
+		for _, containerStatus := range statuses {
+			if waiting := containerStatus.State.Waiting; waiting != nil && !isTransientContainerWaitingReason(waiting.Reason) {
+				return waiting.Reason
+			}
+		}
+	}
+	return ""
+}
+
+// isTransientContainerWaitingReason returns true if the reason is part of a normal container startup.
+func isTransientContainerWaitingReason(reason string) bool {
+	return reason == "" || reason == "ContainerCreating" || reason == "PodInitializing"
+}
+
 func CalculateDesiredResources(cluster *rayv1.RayCluster) corev1.ResourceList {
 	desiredResourcesList := []corev1.ResourceList{{}}
 	headPodResource := CalculatePodResource(cluster.Spec.HeadGroupSpec.Template.Spec)
@@ -712,3 +1169,73 @@ func GetContainerCommand(additionalOptions []string) []string {
 	bashOptionsStr := strings.Join(bashOptions, "")
 	return []string{"/bin/bash", "-" + bashOptionsStr, "--"}
 }
+
+// ParseCronSchedule parses a schedule in the standard five-field cron format. Time zone prefixes such as
+// "TZ=" and "CRON_TZ=" are rejected because the time zone of a suspendSchedule is set by its timeZone field.
+func ParseCronSchedule(spec string) (cron.Schedule, error) {
+	if strings.Contains(spec, "TZ=") {
+		return nil, fmt.Errorf("time zone prefixes are not supported in %q, use timeZone instead", spec)
+	}
+	return cron.ParseStandard(spec)
+}
+
+// GetSuspendScheduleLocation returns the time zone in which the schedules of a suspendSchedule are interpreted.
+func GetSuspendScheduleLocation(schedule *rayv1.SuspendSchedule) (*time.Location, error) {
+	if schedule.TimeZone == nil {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(*schedule.TimeZone)
+}
+
+// GetNextScheduledAction returns the first action of a suspendSchedule that is due strictly after `after`.
+// If the suspend and resume schedules are due at the same time, the RayCluster is suspended. It returns nil
+// if neither schedule is due within the next five years.
+func GetNextScheduledAction(schedule *rayv1.SuspendSchedule, after time.Time) (*rayv1.ScheduledAction, error) {
+	loc, err := GetSuspendScheduleLocation(schedule)
+	if err != nil {
+		return nil, err
+	}
+	var next *rayv1.ScheduledAction
+	for _, s := range []struct {
+		action rayv1.ScheduledActionType
+		spec   string
+	}{
+		{rayv1.ScheduledSuspend, schedule.Suspend},
+		{rayv1.ScheduledResume, schedule.Resume},
+	} {
+		if s.spec == "" {
+			continue
+		}
+		cronSchedule, err := ParseCronSchedule(s.spec)
+		if err != nil {
+			return nil, err
+		}
+		// Next returns the zero time if the schedule is never due, e.g. "0 0 30 2 *".
+		t := cronSchedule.Next(after.In(loc))
+		if t.IsZero() {
+			continue
+		}
+		if next == nil || t.Before(next.Time.Time) {
+			next = &rayv1.ScheduledAction{Action: s.action, Time: metav1.NewTime(t)}
+		}
+	}
+	return next, nil
+}
+
+// GetLatestScheduledAction returns the last action of a suspendSchedule that is due strictly after `after` and no later
+// than `now`, i.e. the action that decides whether the RayCluster is suspended at `now`. It returns nil if no action is
+// due in between.
+func GetLatestScheduledAction(schedule *rayv1.SuspendSchedule, after time.Time, now time.Time) (*rayv1.ScheduledAction, error) {
+	var latest *rayv1.ScheduledAction
+	for {
+		next, err := GetNextScheduledAction(schedule, after)
+		if err != nil {
+			return nil, err
+		}
+		if next == nil || next.Time.After(now) {
+			return latest, nil
+		}
+		latest = next
+		after = next.Time.Time
+	}
+}
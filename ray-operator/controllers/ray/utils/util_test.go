@@ -5,12 +5,14 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
@@ -415,6 +417,228 @@ func TestCalculateAvailableReplicas(t *testing.T) {
 	assert.Equal(t, int32(1), readyCount, "expect 1 ready replica")
 }
 
+func TestCalculateWorkerGroupStatuses(t *testing.T) {
+	ctx := context.Background()
+	cluster := &rayv1.RayCluster{
+		Spec: rayv1.RayClusterSpec{
+			WorkerGroupSpecs: []rayv1.WorkerGroupSpec{
+				{
+					GroupName:   "cpu-group",
+					Replicas:    ptr.To[int32](3),
+					MinReplicas: ptr.To[int32](0),
+					MaxReplicas: ptr.To[int32](5),
+					NumOfHosts:  1,
+				},
+				{
+					GroupName:   "gpu-group",
+					Replicas:    ptr.To[int32](1),
+					MinReplicas: ptr.To[int32](0),
+					MaxReplicas: ptr.To[int32](5),
+					NumOfHosts:  2,
+				},
+			},
+		},
+	}
+	workerPod := func(name, group string, creationTime time.Time, status corev1.PodStatus) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(creationTime),
+				Labels: map[string]string{
+					RayNodeTypeLabelKey:  string(rayv1.WorkerNode),
+					RayNodeGroupLabelKey: group,
+				},
+			},
+			Status: status,
+		}
+	}
+	now := time.Now()
+	podList := corev1.PodList{
+		Items: []corev1.Pod{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "head",
+					Labels: map[string]string{RayNodeTypeLabelKey: string(rayv1.HeadNode)},
+				},
+				Status: corev1.PodStatus{Phase: corev1.PodRunning},
+			},
+			workerPod("cpu-ready", "cpu-group", now, corev1.PodStatus{
+				Phase:      corev1.PodRunning,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			}),
+			workerPod("cpu-crashing", "cpu-group", now, corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}},
+				},
+			}),
+			workerPod("cpu-evicted", "cpu-group", now.Add(-time.Minute), corev1.PodStatus{
+				Phase:  corev1.PodFailed,
+				Reason: "Evicted",
+			}),
+			workerPod("gpu-unschedulable", "gpu-group", now, corev1.PodStatus{
+				Phase: corev1.PodPending,
+				Conditions: []corev1.PodCondition{
+					{Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Reason: corev1.PodReasonUnschedulable},
+				},
+			}),
+			workerPod("gpu-creating", "gpu-group", now, corev1.PodStatus{
+				Phase: corev1.PodPending,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}}},
+				},
+			}),
+			workerPod("unknown-group", "unknown-group", now, corev1.PodStatus{Phase: corev1.PodRunning}),
+		},
+	}
+
+	statuses := CalculateWorkerGroupStatuses(ctx, cluster, podList)
+	assert.Equal(t, []rayv1.WorkerGroupStatus{
+		{
+			GroupName:         "cpu-group",
+			LastFailureReason: "CrashLoopBackOff",
+			DesiredReplicas:   3,
+			ReadyReplicas:     1,
+			AvailableReplicas: 2,
+			FailedReplicas:    1,
+		},
+		{
+			GroupName:         "gpu-group",
+			LastFailureReason: corev1.PodReasonUnschedulable,
+			DesiredReplicas:   2,
+			PendingReplicas:   2,
+		},
+	}, statuses)
+
+	assert.Nil(t, CalculateWorkerGroupStatuses(ctx, &rayv1.RayCluster{}, podList))
+}
+
+func TestCalculateWorkerGroupStatusesMultiHost(t *testing.T) {
+	cluster := &rayv1.RayCluster{
+		Spec: rayv1.RayClusterSpec{
+			WorkerGroupSpecs: []rayv1.WorkerGroupSpec{
+				{
+					GroupName:   "tpu-group",
+					Replicas:    ptr.To[int32](3),
+					MinReplicas: ptr.To[int32](0),
+					MaxReplicas: ptr.To[int32](5),
+					NumOfHosts:  2,
+				},
+			},
+		},
+	}
+	hostPod := func(replicaIndex string, ready bool) corev1.Pod {
+		pod := corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{
+					RayNodeTypeLabelKey:      string(rayv1.WorkerNode),
+					RayNodeGroupLabelKey:     "tpu-group",
+					RayWorkerReplicaIndexKey: replicaIndex,
+				},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+		if ready {
+			pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+		}
+		return pod
+	}
+	podList := corev1.PodList{
+		Items: []corev1.Pod{
+			// Replica 0 is ready, replica 1 has a host that is not ready and replica 2 is missing a host.
+			hostPod("0", true),
+			hostPod("0", true),
+			hostPod("1", true),
+			hostPod("1", false),
+			hostPod("2", true),
+		},
+	}
+
+	statuses := CalculateWorkerGroupStatuses(context.Background(), cluster, podList)
+	require.Len(t, statuses, 1)
+	assert.Equal(t, int32(6), statuses[0].DesiredReplicas)
+	assert.Equal(t, int32(4), statuses[0].ReadyReplicas)
+	assert.Equal(t, int32(1), statuses[0].ReadyMultiHostReplicas)
+}
+
+func TestGetFreeReplicaIndices(t *testing.T) {
+	podWithIndex := func(index string) corev1.Pod {
+		return corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{RayWorkerReplicaIndexKey: index}}}
+	}
+	pods := []corev1.Pod{podWithIndex("0"), podWithIndex("0"), podWithIndex("2"), podWithIndex("invalid"), {}}
+
+	assert.Equal(t, []int{1, 3, 4}, GetFreeReplicaIndices(pods, 3))
+	assert.Empty(t, GetFreeReplicaIndices(pods, 0))
+
+	replicas, unindexed := GroupPodsByReplicaIndex(pods)
+	assert.Len(t, replicas[0], 2)
+	assert.Len(t, replicas[2], 1)
+	assert.Len(t, unindexed, 2)
+}
+
+func TestGetNextScheduledAction(t *testing.T) {
+	schedule := &rayv1.SuspendSchedule{
+		Suspend:  "0 20 * * 1-5",
+		Resume:   "0 7 * * 1-5",
+		TimeZone: ptr.To("Europe/Paris"),
+	}
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+
+	// Friday 2025-06-06 12:00 in Paris: the RayCluster is suspended in the evening.
+	next, err := GetNextScheduledAction(schedule, time.Date(2025, 6, 6, 12, 0, 0, 0, paris))
+	require.NoError(t, err)
+	assert.Equal(t, rayv1.ScheduledSuspend, next.Action)
+	assert.True(t, next.Time.Time.Equal(time.Date(2025, 6, 6, 20, 0, 0, 0, paris)))
+
+	// Friday 2025-06-06 20:00 in Paris, given in UTC: the RayCluster is resumed on Monday morning.
+	next, err = GetNextScheduledAction(schedule, time.Date(2025, 6, 6, 18, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, rayv1.ScheduledResume, next.Action)
+	assert.True(t, next.Time.Time.Equal(time.Date(2025, 6, 9, 7, 0, 0, 0, paris)))
+
+	// Without a time zone, the schedules are interpreted in UTC.
+	next, err = GetNextScheduledAction(&rayv1.SuspendSchedule{Resume: "0 7 * * *"}, time.Date(2025, 6, 6, 12, 0, 0, 0, paris))
+	require.NoError(t, err)
+	assert.Equal(t, rayv1.ScheduledResume, next.Action)
+	assert.True(t, next.Time.Time.Equal(time.Date(2025, 6, 7, 7, 0, 0, 0, time.UTC)))
+
+	// A schedule that is never due has no next action.
+	next, err = GetNextScheduledAction(&rayv1.SuspendSchedule{Suspend: "0 0 30 2 *"}, time.Now())
+	require.NoError(t, err)
+	assert.Nil(t, next)
+
+	_, err = GetNextScheduledAction(&rayv1.SuspendSchedule{Suspend: "invalid"}, time.Now())
+	require.Error(t, err)
+}
+
+func TestGetLatestScheduledAction(t *testing.T) {
+	schedule := &rayv1.SuspendSchedule{
+		Suspend:  "0 20 * * 1-5",
+		Resume:   "0 7 * * 1-5",
+		TimeZone: ptr.To("Europe/Paris"),
+	}
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+
+	// From Friday 2025-06-06 19:00 to Monday 2025-06-09 12:00 in Paris: the RayCluster was suspended on Friday
+	// evening and resumed on Monday morning, so it should be resumed.
+	latest, err := GetLatestScheduledAction(schedule, time.Date(2025, 6, 6, 19, 0, 0, 0, paris), time.Date(2025, 6, 9, 12, 0, 0, 0, paris))
+	require.NoError(t, err)
+	assert.Equal(t, rayv1.ScheduledResume, latest.Action)
+	assert.True(t, latest.Time.Time.Equal(time.Date(2025, 6, 9, 7, 0, 0, 0, paris)))
+
+	// An action due exactly at `now` is included.
+	latest, err = GetLatestScheduledAction(schedule, time.Date(2025, 6, 6, 19, 0, 0, 0, paris), time.Date(2025, 6, 6, 20, 0, 0, 0, paris))
+	require.NoError(t, err)
+	assert.Equal(t, rayv1.ScheduledSuspend, latest.Action)
+
+	// No action is due in between.
+	latest, err = GetLatestScheduledAction(schedule, time.Date(2025, 6, 6, 20, 0, 0, 0, paris), time.Date(2025, 6, 8, 12, 0, 0, 0, paris))
+	require.NoError(t, err)
+	assert.Nil(t, latest)
+}
+
 func TestFindContainerPort(t *testing.T) {
 	container := corev1.Container{
 		Name: "ray-head",
@@ -540,6 +764,170 @@ func TestGetWorkerGroupDesiredReplicas(t *testing.T) {
 	assert.Equal(t, GetWorkerGroupDesiredReplicas(ctx, workerGroupSpec), replicas*numOfHosts)
 }
 
+func TestGetWorkerGroupRollingUpdateBounds(t *testing.T) {
+	tests := []struct {
+		rollingUpdate          *rayv1.RollingUpdateWorkerGroupStrategy
+		name                   string
+		desiredPods            int
+		expectedMaxSurge       int
+		expectedMaxUnavailable int
+	}{
+		{
+			name:                   "default values are 25%",
+			rollingUpdate:          nil,
+			desiredPods:            8,
+			expectedMaxSurge:       2,
+			expectedMaxUnavailable: 2,
+		},
+		{
+			name:                   "maxSurge rounds up and maxUnavailable rounds down",
+			rollingUpdate:          nil,
+			desiredPods:            3,
+			expectedMaxSurge:       1,
+			expectedMaxUnavailable: 0,
+		},
+		{
+			name: "absolute numbers",
+			rollingUpdate: &rayv1.RollingUpdateWorkerGroupStrategy{
+				MaxSurge:       ptr.To(intstr.FromInt32(0)),
+				MaxUnavailable: ptr.To(intstr.FromInt32(3)),
+			},
+			desiredPods:            8,
+			expectedMaxSurge:       0,
+			expectedMaxUnavailable: 3,
+		},
+		{
+			name: "maxUnavailable is 1 if both values are 0",
+			rollingUpdate: &rayv1.RollingUpdateWorkerGroupStrategy{
+				MaxSurge: ptr.To(intstr.FromInt32(0)),
+			},
+			desiredPods:            1,
+			expectedMaxSurge:       0,
+			expectedMaxUnavailable: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			workerGroupSpec := rayv1.WorkerGroupSpec{
+				UpdateStrategy: &rayv1.WorkerGroupUpdateStrategy{
+					Type:          ptr.To(rayv1.RollingUpdateStrategy),
+					RollingUpdate: tc.rollingUpdate,
+				},
+			}
+			maxSurge, maxUnavailable, err := GetWorkerGroupRollingUpdateBounds(workerGroupSpec, tc.desiredPods)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedMaxSurge, maxSurge)
+			assert.Equal(t, tc.expectedMaxUnavailable, maxUnavailable)
+		})
+	}
+}
+
+func TestGenerateWorkerGroupTemplateHash(t *testing.T) {
+	workerGroupSpec := rayv1.WorkerGroupSpec{
+		GroupName: "small-group",
+		Replicas:  ptr.To[int32](1),
+		Template: corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "ray-worker", Image: "rayproject/ray:2.46.0"}},
+			},
+		},
+	}
+	hash, err := GenerateWorkerGroupTemplateHash(workerGroupSpec)
+	require.NoError(t, err)
+
+	// Nil and empty rayStartParams generate the same hash.
+	workerGroupSpec.RayStartParams = map[string]string{}
+	emptyParamsHash, err := GenerateWorkerGroupTemplateHash(workerGroupSpec)
+	require.NoError(t, err)
+	assert.Equal(t, hash, emptyParamsHash)
+
+	// Scaling fields do not change the hash.
+	workerGroupSpec.Replicas = ptr.To[int32](5)
+	workerGroupSpec.ScaleStrategy.WorkersToDelete = []string{"pod1"}
+	scaledHash, err := GenerateWorkerGroupTemplateHash(workerGroupSpec)
+	require.NoError(t, err)
+	assert.Equal(t, hash, scaledHash)
+
+	// Changing the template changes the hash.
+	workerGroupSpec.Template.Spec.Containers[0].Image = "rayproject/ray:2.47.0"
+	updatedHash, err := GenerateWorkerGroupTemplateHash(workerGroupSpec)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, updatedHash)
+
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{RayWorkerGroupTemplateHashKey: hash},
+		},
+	}
+	assert.True(t, IsWorkerPodOutdated(pod, updatedHash))
+	assert.False(t, IsWorkerPodOutdated(pod, hash))
+
+	// Pods without the annotation are considered up to date.
+	assert.False(t, IsWorkerPodOutdated(corev1.Pod{}, updatedHash))
+}
+
+func TestCalculateDisruptionBudgetMinAvailablePods(t *testing.T) {
+	tests := []struct {
+		budget               rayv1.DisruptionBudget
+		name                 string
+		replicas             int32
+		numOfHosts           int32
+		expectedMinAvailable int32
+	}{
+		{
+			name:                 "maxUnavailable defaults to 1",
+			budget:               rayv1.DisruptionBudget{},
+			replicas:             3,
+			numOfHosts:           1,
+			expectedMinAvailable: 2,
+		},
+		{
+			name:                 "head group with minAvailable",
+			budget:               rayv1.DisruptionBudget{MinAvailable: ptr.To(intstr.FromInt32(1))},
+			replicas:             1,
+			numOfHosts:           1,
+			expectedMinAvailable: 1,
+		},
+		{
+			name:                 "multi-host replicas with maxUnavailable",
+			budget:               rayv1.DisruptionBudget{MaxUnavailable: ptr.To(intstr.FromInt32(1))},
+			replicas:             3,
+			numOfHosts:           4,
+			expectedMinAvailable: 11,
+		},
+		{
+			name:                 "multi-host replicas with minAvailable percentage rounded up",
+			budget:               rayv1.DisruptionBudget{MinAvailable: ptr.To(intstr.FromString("50%"))},
+			replicas:             3,
+			numOfHosts:           2,
+			expectedMinAvailable: 5,
+		},
+		{
+			name:                 "maxUnavailable is capped at replicas",
+			budget:               rayv1.DisruptionBudget{MaxUnavailable: ptr.To(intstr.FromInt32(5))},
+			replicas:             2,
+			numOfHosts:           2,
+			expectedMinAvailable: 2,
+		},
+		{
+			name:                 "no replicas",
+			budget:               rayv1.DisruptionBudget{},
+			replicas:             0,
+			numOfHosts:           2,
+			expectedMinAvailable: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			minAvailable, err := CalculateDisruptionBudgetMinAvailablePods(tc.budget, tc.replicas, tc.numOfHosts)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedMinAvailable, minAvailable)
+		})
+	}
+}
+
 func TestCalculateMinAndMaxReplicas(t *testing.T) {
 	suspend := true
 
@@ -817,6 +1205,77 @@ func TestFindHeadPodReadyCondition(t *testing.T) {
 	}
 }
 
+func TestFindPodFailureConditions(t *testing.T) {
+	pod := func(nodeType rayv1.RayNodeType, group string, status corev1.PodStatus) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: group + "-pod",
+				Labels: map[string]string{
+					RayNodeTypeLabelKey:  string(nodeType),
+					RayNodeGroupLabelKey: group,
+				},
+			},
+			Status: status,
+		}
+	}
+	waiting := func(reason string) corev1.PodStatus {
+		return corev1.PodStatus{
+			Phase: corev1.PodPending,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "ray", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: reason}}},
+			},
+		}
+	}
+	unschedulable := corev1.PodStatus{
+		Phase: corev1.PodPending,
+		Conditions: []corev1.PodCondition{
+			{Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Reason: corev1.PodReasonUnschedulable},
+		},
+	}
+	terminatingPod := pod(rayv1.WorkerNode, "cpu-group", unschedulable)
+	terminatingPod.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+
+	pods := corev1.PodList{
+		Items: []corev1.Pod{
+			pod(rayv1.HeadNode, RayNodeHeadGroupLabelValue, unschedulable),
+			pod(rayv1.HeadNode, RayNodeHeadGroupLabelValue, waiting("ErrImagePull")),
+			pod(rayv1.WorkerNode, "gpu-group", unschedulable),
+			pod(rayv1.WorkerNode, "gpu-group", unschedulable),
+			pod(rayv1.WorkerNode, "cpu-group", unschedulable),
+			pod(rayv1.WorkerNode, "cpu-group", waiting("ImagePullBackOff")),
+			pod(rayv1.WorkerNode, "cpu-group", waiting("ContainerCreating")),
+			terminatingPod,
+		},
+	}
+
+	condition, ok := FindWorkersUnschedulableCondition(pods)
+	require.True(t, ok)
+	assert.Equal(t, string(rayv1.RayClusterWorkersUnschedulable), condition.Type)
+	assert.Equal(t, "Unschedulable worker Pods by group: cpu-group (1), gpu-group (2)", condition.Message)
+
+	condition, ok = FindImagePullFailureCondition(pods)
+	require.True(t, ok)
+	assert.Equal(t, string(rayv1.RayClusterImagePullFailure), condition.Type)
+	assert.Equal(t, "Pods failing to pull images by group: cpu-group (1), headgroup (1)", condition.Message)
+
+	_, ok = FindHeadCrashLoopingCondition(&pods.Items[1])
+	assert.False(t, ok)
+	_, ok = FindHeadCrashLoopingCondition(nil)
+	assert.False(t, ok)
+	condition, ok = FindHeadCrashLoopingCondition(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "head"},
+		Status:     waiting("CrashLoopBackOff"),
+	})
+	require.True(t, ok)
+	assert.Equal(t, string(rayv1.RayClusterHeadCrashLooping), condition.Type)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+
+	_, ok = FindWorkersUnschedulableCondition(corev1.PodList{Items: []corev1.Pod{pod(rayv1.WorkerNode, "cpu-group", waiting("ContainerCreating"))}})
+	assert.False(t, ok)
+	_, ok = FindImagePullFailureCondition(corev1.PodList{Items: []corev1.Pod{pod(rayv1.WorkerNode, "cpu-group", unschedulable)}})
+	assert.False(t, ok)
+}
+
 func TestErrRayClusterReplicaFailureReason(t *testing.T) {
 	assert.Equal(t, "FailedDeleteAllPods", RayClusterReplicaFailureReason(ErrFailedDeleteAllPods))
 	assert.Equal(t, "FailedDeleteHeadPod", RayClusterReplicaFailureReason(ErrFailedDeleteHeadPod))
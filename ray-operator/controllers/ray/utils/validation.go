@@ -3,11 +3,16 @@ package utils
 import (
 	errstd "errors"
 	"fmt"
+	"net"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/ptr"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
 	"github.com/ray-project/kuberay/ray-operator/pkg/features"
@@ -37,11 +42,71 @@ func ValidateRayClusterSpec(spec *rayv1.RayClusterSpec, annotations map[string]s
 	if len(spec.HeadGroupSpec.Template.Spec.Containers) == 0 {
 		return fmt.Errorf("headGroupSpec should have at least one container")
 	}
+	if err := validateDisruptionBudget(spec.HeadGroupSpec.DisruptionBudget); err != nil {
+		return fmt.Errorf("headGroupSpec has an invalid disruptionBudget: %w", err)
+	}
+	if err := validateVolumeClaimTemplates(spec.HeadGroupSpec.VolumeClaimTemplates, spec.HeadGroupSpec.VolumeClaimRetentionPolicy); err != nil {
+		return fmt.Errorf("headGroupSpec has invalid volumeClaimTemplates: %w", err)
+	}
 
 	for _, workerGroup := range spec.WorkerGroupSpecs {
 		if len(workerGroup.Template.Spec.Containers) == 0 {
 			return fmt.Errorf("workerGroupSpec should have at least one container")
 		}
+		if err := validateWorkerGroupUpdateStrategy(workerGroup); err != nil {
+			return err
+		}
+		if err := validateDisruptionBudget(workerGroup.DisruptionBudget); err != nil {
+			return fmt.Errorf("worker group %s has an invalid disruptionBudget: %w", workerGroup.GroupName, err)
+		}
+		if err := validateVolumeClaimTemplates(workerGroup.VolumeClaimTemplates, workerGroup.VolumeClaimRetentionPolicy); err != nil {
+			return fmt.Errorf("worker group %s has invalid volumeClaimTemplates: %w", workerGroup.GroupName, err)
+		}
+	}
+
+	if spec.IdleSuspendPolicy != nil && spec.IdleSuspendPolicy.IdleTimeoutSeconds <= 0 {
+		return fmt.Errorf("idleSuspendPolicy.idleTimeoutSeconds must be a positive integer")
+	}
+
+	if spec.SuspendSchedule != nil {
+		if err := validateSuspendSchedule(spec.SuspendSchedule); err != nil {
+			return fmt.Errorf("invalid suspendSchedule: %w", err)
+		}
+	}
+
+	if spec.SecurityOptions != nil && spec.SecurityOptions.CertificateIssuer != nil {
+		if err := ValidateCertificateIssuer(spec.SecurityOptions.CertificateIssuer); err != nil {
+			return fmt.Errorf("invalid securityOptions.certificateIssuer: %w", err)
+		}
+	}
+
+	if template, ok := annotations[RayDashboardPathTemplateAnnotationKey]; ok {
+		if err := ValidateDashboardPathTemplate(template); err != nil {
+			return fmt.Errorf("invalid %s annotation: %w", RayDashboardPathTemplateAnnotationKey, err)
+		}
+	}
+
+	if spec.SecurityOptions != nil {
+		// The auth proxy may also be enabled by the secure trusted network annotation
+		authProxyEnabled := IsAuthProxyEnabled(&rayv1.RayCluster{ObjectMeta: metav1.ObjectMeta{Annotations: annotations}, Spec: *spec})
+		if ptr.Deref(spec.SecurityOptions.ServeAuthProxy, false) && !authProxyEnabled {
+			return fmt.Errorf("securityOptions.serveAuthProxy requires securityOptions.authProxy to be enabled")
+		}
+		if ptr.Deref(spec.SecurityOptions.ClientAuthProxy, false) && !authProxyEnabled {
+			return fmt.Errorf("securityOptions.clientAuthProxy requires securityOptions.authProxy to be enabled")
+		}
+	}
+
+	if spec.SecurityOptions != nil && spec.SecurityOptions.DashboardAccessPolicy != nil {
+		if err := ValidateDashboardAccessPolicy(spec.SecurityOptions.DashboardAccessPolicy); err != nil {
+			return fmt.Errorf("invalid securityOptions.dashboardAccessPolicy: %w", err)
+		}
+	}
+
+	if spec.NetworkPolicy != nil {
+		if err := ValidateNetworkPolicyOptions(spec.NetworkPolicy); err != nil {
+			return fmt.Errorf("invalid networkPolicy: %w", err)
+		}
 	}
 
 	if annotations[RayFTEnabledAnnotationKey] != "" && spec.GcsFaultToleranceOptions != nil {
@@ -119,6 +184,291 @@ func ValidateRayClusterSpec(spec *rayv1.RayClusterSpec, annotations map[string]s
 	return nil
 }
 
+func validateWorkerGroupUpdateStrategy(workerGroup rayv1.WorkerGroupSpec) error {
+	if workerGroup.UpdateStrategy == nil {
+		return nil
+	}
+	strategyType := GetWorkerGroupUpdateStrategyType(workerGroup)
+	if strategyType != rayv1.OnDeleteUpdateStrategy &&
+		strategyType != rayv1.RecreateUpdateStrategy &&
+		strategyType != rayv1.RollingUpdateStrategy {
+		return fmt.Errorf("worker group %s has invalid updateStrategy.type %s, valid options are %s, %s or %s",
+			workerGroup.GroupName, strategyType, rayv1.OnDeleteUpdateStrategy, rayv1.RecreateUpdateStrategy, rayv1.RollingUpdateStrategy)
+	}
+	rollingUpdate := workerGroup.UpdateStrategy.RollingUpdate
+	if rollingUpdate == nil {
+		return nil
+	}
+	if strategyType != rayv1.RollingUpdateStrategy {
+		return fmt.Errorf("worker group %s sets updateStrategy.rollingUpdate, which is only allowed when updateStrategy.type is %s",
+			workerGroup.GroupName, rayv1.RollingUpdateStrategy)
+	}
+	// Resolve the values against 100 Pods so that percentages are checked as well.
+	maxSurge, maxUnavailable := 1, 1
+	var err error
+	if rollingUpdate.MaxSurge != nil {
+		if maxSurge, err = intstr.GetScaledValueFromIntOrPercent(rollingUpdate.MaxSurge, 100, true); err != nil {
+			return fmt.Errorf("worker group %s has an invalid updateStrategy.rollingUpdate.maxSurge: %w", workerGroup.GroupName, err)
+		}
+	}
+	if rollingUpdate.MaxUnavailable != nil {
+		if maxUnavailable, err = intstr.GetScaledValueFromIntOrPercent(rollingUpdate.MaxUnavailable, 100, false); err != nil {
+			return fmt.Errorf("worker group %s has an invalid updateStrategy.rollingUpdate.maxUnavailable: %w", workerGroup.GroupName, err)
+		}
+	}
+	if maxSurge < 0 || maxUnavailable < 0 {
+		return fmt.Errorf("worker group %s has negative maxSurge or maxUnavailable in updateStrategy.rollingUpdate", workerGroup.GroupName)
+	}
+	if maxSurge == 0 && maxUnavailable == 0 {
+		return fmt.Errorf("worker group %s cannot set both maxSurge and maxUnavailable to 0 in updateStrategy.rollingUpdate", workerGroup.GroupName)
+	}
+	return nil
+}
+
+func validateDisruptionBudget(budget *rayv1.DisruptionBudget) error {
+	if budget == nil {
+		return nil
+	}
+	if budget.MinAvailable != nil && budget.MaxUnavailable != nil {
+		return fmt.Errorf("minAvailable and maxUnavailable cannot be both set")
+	}
+	for name, value := range map[string]*intstr.IntOrString{"minAvailable": budget.MinAvailable, "maxUnavailable": budget.MaxUnavailable} {
+		if value == nil {
+			continue
+		}
+		// Resolve the value against 100 replicas so that percentages are checked as well.
+		scaled, err := intstr.GetScaledValueFromIntOrPercent(value, 100, true)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", name, err)
+		}
+		if scaled < 0 {
+			return fmt.Errorf("%s cannot be negative", name)
+		}
+	}
+	return nil
+}
+
+func validateVolumeClaimTemplates(templates []corev1.PersistentVolumeClaim, policy *rayv1.VolumeClaimRetentionPolicy) error {
+	names := make(map[string]bool, len(templates))
+	for _, template := range templates {
+		if template.Name == "" {
+			return fmt.Errorf("the name of a volumeClaimTemplate cannot be empty")
+		}
+		if errs := validation.IsDNS1123Label(template.Name); len(errs) > 0 {
+			return fmt.Errorf("volumeClaimTemplate name %s should be a valid DNS1123 label: %v", template.Name, errs)
+		}
+		if names[template.Name] {
+			return fmt.Errorf("volumeClaimTemplate name %s is duplicated", template.Name)
+		}
+		names[template.Name] = true
+	}
+	if policy == nil {
+		return nil
+	}
+	for _, field := range []struct {
+		value *rayv1.VolumeClaimRetentionPolicyType
+		name  string
+	}{
+		{name: "whenPodDeleted", value: policy.WhenPodDeleted},
+		{name: "whenClusterDeleted", value: policy.WhenClusterDeleted},
+	} {
+		if field.value != nil && *field.value != rayv1.RetainVolumeClaimRetentionPolicy && *field.value != rayv1.DeleteVolumeClaimRetentionPolicy {
+			return fmt.Errorf("volumeClaimRetentionPolicy.%s %s is invalid, valid options are %s or %s",
+				field.name, *field.value, rayv1.RetainVolumeClaimRetentionPolicy, rayv1.DeleteVolumeClaimRetentionPolicy)
+		}
+	}
+	whenPodDeleted, whenClusterDeleted := GetVolumeClaimRetentionPolicy(policy)
+	if whenPodDeleted == rayv1.DeleteVolumeClaimRetentionPolicy && whenClusterDeleted == rayv1.RetainVolumeClaimRetentionPolicy {
+		return fmt.Errorf("volumeClaimRetentionPolicy.whenPodDeleted cannot be %s when whenClusterDeleted is %s, because Pods are deleted together with the RayCluster",
+			rayv1.DeleteVolumeClaimRetentionPolicy, rayv1.RetainVolumeClaimRetentionPolicy)
+	}
+	return nil
+}
+
+func validateSuspendSchedule(schedule *rayv1.SuspendSchedule) error {
+	if schedule.Suspend == "" && schedule.Resume == "" {
+		return fmt.Errorf("at least one of suspend and resume should be set")
+	}
+	for _, field := range []struct {
+		name string
+		spec string
+	}{
+		{name: "suspend", spec: schedule.Suspend},
+		{name: "resume", spec: schedule.Resume},
+	} {
+		if field.spec == "" {
+			continue
+		}
+		if _, err := ParseCronSchedule(field.spec); err != nil {
+			return fmt.Errorf("%s is not a valid cron schedule: %w", field.name, err)
+		}
+	}
+	if _, err := GetSuspendScheduleLocation(schedule); err != nil {
+		return fmt.Errorf("timeZone is invalid: %w", err)
+	}
+	return nil
+}
+
+// ValidateCertificateIssuer validates an mTLS certificate issuer of a RayCluster or of the operator configuration.
+func ValidateCertificateIssuer(issuer *rayv1.CertificateIssuer) error {
+	if issuer.Name == "" {
+		return fmt.Errorf("name should be set")
+	}
+	kind, group := GetCertificateIssuerKindAndGroup(issuer)
+	if group == DefaultCertificateIssuerGroup && kind != "Issuer" && kind != "ClusterIssuer" {
+		return fmt.Errorf("kind should be Issuer or ClusterIssuer for the %s group, got %s", group, kind)
+	}
+	return nil
+}
+
+func ValidateDashboardAccessPolicy(policy *rayv1.DashboardAccessPolicy) error {
+	if policy.ResourceAttributes == nil {
+		return nil
+	}
+	if len(policy.Users) > 0 || len(policy.Groups) > 0 {
+		return fmt.Errorf("resourceAttributes cannot be set together with users or groups")
+	}
+	if policy.ResourceAttributes.Resource == "" {
+		return fmt.Errorf("resourceAttributes.resource should be set")
+	}
+	return nil
+}
+
+// ValidateNetworkPolicyOptions validates the user-defined rules added to the NetworkPolicies of a RayCluster.
+func ValidateNetworkPolicyOptions(options *rayv1.NetworkPolicyOptions) error {
+	for i, rule := range options.HeadIngress {
+		if err := validateNetworkPolicyIngressRule(rule); err != nil {
+			return fmt.Errorf("headIngress[%d]: %w", i, err)
+		}
+	}
+	for i, rule := range options.WorkerIngress {
+		if err := validateNetworkPolicyIngressRule(rule); err != nil {
+			return fmt.Errorf("workerIngress[%d]: %w", i, err)
+		}
+	}
+	if options.Egress != nil {
+		for i, rule := range options.Egress.Allow {
+			if err := validateNetworkPolicyEgressRule(rule); err != nil {
+				return fmt.Errorf("egress.allow[%d]: %w", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+func validateNetworkPolicyEgressRule(rule networkingv1.NetworkPolicyEgressRule) error {
+	// A rule without peers and ports allows all the egress traffic, which defeats the purpose of the allowlist.
+	if len(rule.To) == 0 && len(rule.Ports) == 0 {
+		return fmt.Errorf("to or ports should list at least one peer or port")
+	}
+	for i, peer := range rule.To {
+		if err := validateNetworkPolicyPeer(peer); err != nil {
+			return fmt.Errorf("to[%d]: %w", i, err)
+		}
+	}
+	for i, port := range rule.Ports {
+		if err := validateNetworkPolicyPort(port); err != nil {
+			return fmt.Errorf("ports[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func validateNetworkPolicyIngressRule(rule networkingv1.NetworkPolicyIngressRule) error {
+	// A rule without peers allows traffic from anywhere, which defeats the purpose of the NetworkPolicies.
+	if len(rule.From) == 0 {
+		return fmt.Errorf("from should list at least one peer")
+	}
+	for i, peer := range rule.From {
+		if err := validateNetworkPolicyPeer(peer); err != nil {
+			return fmt.Errorf("from[%d]: %w", i, err)
+		}
+	}
+	for i, port := range rule.Ports {
+		if err := validateNetworkPolicyPort(port); err != nil {
+			return fmt.Errorf("ports[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func validateNetworkPolicyPeer(peer networkingv1.NetworkPolicyPeer) error {
+	if peer.IPBlock != nil {
+		if peer.PodSelector != nil || peer.NamespaceSelector != nil {
+			return fmt.Errorf("ipBlock cannot be set together with podSelector or namespaceSelector")
+		}
+		_, cidr, err := net.ParseCIDR(peer.IPBlock.CIDR)
+		if err != nil {
+			return fmt.Errorf("ipBlock.cidr %q is not a valid CIDR", peer.IPBlock.CIDR)
+		}
+		for _, except := range peer.IPBlock.Except {
+			exceptIP, _, err := net.ParseCIDR(except)
+			if err != nil {
+				return fmt.Errorf("ipBlock.except %q is not a valid CIDR", except)
+			}
+			if !cidr.Contains(exceptIP) {
+				return fmt.Errorf("ipBlock.except %q is not within ipBlock.cidr %q", except, peer.IPBlock.CIDR)
+			}
+		}
+		return nil
+	}
+	if peer.PodSelector == nil && peer.NamespaceSelector == nil {
+		return fmt.Errorf("one of podSelector, namespaceSelector or ipBlock should be set")
+	}
+	if _, err := metav1.LabelSelectorAsSelector(peer.PodSelector); err != nil {
+		return fmt.Errorf("invalid podSelector: %w", err)
+	}
+	if _, err := metav1.LabelSelectorAsSelector(peer.NamespaceSelector); err != nil {
+		return fmt.Errorf("invalid namespaceSelector: %w", err)
+	}
+	return nil
+}
+
+func validateNetworkPolicyPort(port networkingv1.NetworkPolicyPort) error {
+	if port.Protocol != nil {
+		switch *port.Protocol {
+		case corev1.ProtocolTCP, corev1.ProtocolUDP, corev1.ProtocolSCTP:
+		default:
+			return fmt.Errorf("unsupported protocol %q", *port.Protocol)
+		}
+	}
+	if port.Port != nil {
+		if port.Port.Type == intstr.Int {
+			if errs := validation.IsValidPortNum(port.Port.IntValue()); len(errs) > 0 {
+				return fmt.Errorf("invalid port %d: %v", port.Port.IntValue(), errs)
+			}
+		} else if errs := validation.IsValidPortName(port.Port.StrVal); len(errs) > 0 {
+			return fmt.Errorf("invalid port %q: %v", port.Port.StrVal, errs)
+		}
+	}
+	if port.EndPort != nil {
+		if port.Port == nil || port.Port.Type != intstr.Int {
+			return fmt.Errorf("endPort requires a numeric port")
+		}
+		if int(*port.EndPort) < port.Port.IntValue() {
+			return fmt.Errorf("endPort %d should not be lower than port %d", *port.EndPort, port.Port.IntValue())
+		}
+	}
+	return nil
+}
+
+// ValidateDashboardPathTemplate validates the path template of the HTTPRoute of the Ray dashboard. The template must
+// contain both the {namespace} and {name} placeholders, so that the RayClusters sharing a Gateway never get the same path.
+func ValidateDashboardPathTemplate(template string) error {
+	if !strings.HasPrefix(template, "/") {
+		return fmt.Errorf("path template should start with /, got %q", template)
+	}
+	if strings.HasSuffix(template, "/") {
+		return fmt.Errorf("path template should not end with /, got %q", template)
+	}
+	for _, placeholder := range []string{"{namespace}", "{name}"} {
+		if !strings.Contains(template, placeholder) {
+			return fmt.Errorf("path template should contain the %s placeholder, got %q", placeholder, template)
+		}
+	}
+	return nil
+}
+
 func ValidateRayJobStatus(rayJob *rayv1.RayJob) error {
 	if rayJob.Status.JobDeploymentStatus == rayv1.JobDeploymentStatusWaiting && rayJob.Spec.SubmissionMode != rayv1.InteractiveMode {
 		return fmt.Errorf("invalid RayJob State: JobDeploymentStatus cannot be `Waiting` when SubmissionMode is not InteractiveMode")
@@ -174,6 +524,13 @@ func ValidateRayJobSpec(rayJob *rayv1.RayJob) error {
 		if err := ValidateRayClusterSpec(rayJob.Spec.RayClusterSpec, rayJob.Annotations); err != nil {
 			return err
 		}
+		// The RayJob controls the lifecycle of its RayCluster, including the suspend operation.
+		if rayJob.Spec.RayClusterSpec.IdleSuspendPolicy != nil {
+			return fmt.Errorf("the RayClusterSpec of a RayJob cannot have an idleSuspendPolicy")
+		}
+		if rayJob.Spec.RayClusterSpec.SuspendSchedule != nil {
+			return fmt.Errorf("the RayClusterSpec of a RayJob cannot have a suspendSchedule")
+		}
 	}
 
 	// Validate whether RuntimeEnvYAML is a valid YAML string. Note that this only checks its validity
@@ -229,6 +586,14 @@ func ValidateRayServiceSpec(rayService *rayv1.RayService) error {
 		return err
 	}
 
+	if rayService.Spec.RayClusterSpec.IdleSuspendPolicy != nil {
+		return fmt.Errorf("spec.rayClusterConfig.idleSuspendPolicy should not be set")
+	}
+
+	if rayService.Spec.RayClusterSpec.SuspendSchedule != nil {
+		return fmt.Errorf("spec.rayClusterConfig.suspendSchedule should not be set")
+	}
+
 	if headSvc := rayService.Spec.RayClusterSpec.HeadGroupSpec.HeadService; headSvc != nil && headSvc.Name != "" {
 		return fmt.Errorf("spec.rayClusterConfig.headGroupSpec.headService.metadata.name should not be set")
 	}
@@ -8,7 +8,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
@@ -655,6 +657,636 @@ func TestValidateRayClusterSpecAutoscaler(t *testing.T) {
 	}
 }
 
+func TestValidateRayClusterSpecWorkerGroupUpdateStrategy(t *testing.T) {
+	tests := []struct {
+		updateStrategy *rayv1.WorkerGroupUpdateStrategy
+		name           string
+		errorMessage   string
+		expectError    bool
+	}{
+		{
+			name:           "no update strategy",
+			updateStrategy: nil,
+			expectError:    false,
+		},
+		{
+			name: "Recreate",
+			updateStrategy: &rayv1.WorkerGroupUpdateStrategy{
+				Type: ptr.To(rayv1.RecreateUpdateStrategy),
+			},
+			expectError: false,
+		},
+		{
+			name: "RollingUpdate with maxSurge and maxUnavailable",
+			updateStrategy: &rayv1.WorkerGroupUpdateStrategy{
+				Type: ptr.To(rayv1.RollingUpdateStrategy),
+				RollingUpdate: &rayv1.RollingUpdateWorkerGroupStrategy{
+					MaxSurge:       ptr.To(intstr.FromInt32(1)),
+					MaxUnavailable: ptr.To(intstr.FromString("50%")),
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid type",
+			updateStrategy: &rayv1.WorkerGroupUpdateStrategy{
+				Type: ptr.To(rayv1.WorkerGroupUpdateStrategyType("InPlace")),
+			},
+			expectError:  true,
+			errorMessage: "worker group worker-group-1 has invalid updateStrategy.type InPlace, valid options are OnDelete, Recreate or RollingUpdate",
+		},
+		{
+			name: "rollingUpdate with Recreate",
+			updateStrategy: &rayv1.WorkerGroupUpdateStrategy{
+				Type:          ptr.To(rayv1.RecreateUpdateStrategy),
+				RollingUpdate: &rayv1.RollingUpdateWorkerGroupStrategy{},
+			},
+			expectError:  true,
+			errorMessage: "worker group worker-group-1 sets updateStrategy.rollingUpdate, which is only allowed when updateStrategy.type is RollingUpdate",
+		},
+		{
+			name: "maxSurge and maxUnavailable are both 0",
+			updateStrategy: &rayv1.WorkerGroupUpdateStrategy{
+				Type: ptr.To(rayv1.RollingUpdateStrategy),
+				RollingUpdate: &rayv1.RollingUpdateWorkerGroupStrategy{
+					MaxSurge:       ptr.To(intstr.FromInt32(0)),
+					MaxUnavailable: ptr.To(intstr.FromString("0%")),
+				},
+			},
+			expectError:  true,
+			errorMessage: "worker group worker-group-1 cannot set both maxSurge and maxUnavailable to 0 in updateStrategy.rollingUpdate",
+		},
+		{
+			name: "invalid percentage",
+			updateStrategy: &rayv1.WorkerGroupUpdateStrategy{
+				Type: ptr.To(rayv1.RollingUpdateStrategy),
+				RollingUpdate: &rayv1.RollingUpdateWorkerGroupStrategy{
+					MaxSurge: ptr.To(intstr.FromString("one")),
+				},
+			},
+			expectError:  true,
+			errorMessage: "worker group worker-group-1 has an invalid updateStrategy.rollingUpdate.maxSurge: invalid value for IntOrString: invalid type: string is not a percentage",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := rayv1.RayClusterSpec{
+				HeadGroupSpec: rayv1.HeadGroupSpec{
+					Template: podTemplateSpec(nil, nil),
+				},
+				WorkerGroupSpecs: []rayv1.WorkerGroupSpec{
+					{
+						GroupName:      "worker-group-1",
+						Template:       podTemplateSpec(nil, nil),
+						UpdateStrategy: tt.updateStrategy,
+					},
+				},
+			}
+			err := ValidateRayClusterSpec(&spec, nil)
+			if tt.expectError {
+				require.Error(t, err)
+				assert.EqualError(t, err, tt.errorMessage)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRayClusterSpecDisruptionBudget(t *testing.T) {
+	tests := []struct {
+		headBudget   *rayv1.DisruptionBudget
+		workerBudget *rayv1.DisruptionBudget
+		name         string
+		errorMessage string
+		expectError  bool
+	}{
+		{
+			name:        "no disruption budget",
+			expectError: false,
+		},
+		{
+			name:         "empty disruption budget",
+			headBudget:   &rayv1.DisruptionBudget{},
+			workerBudget: &rayv1.DisruptionBudget{},
+			expectError:  false,
+		},
+		{
+			name:         "minAvailable and maxUnavailable",
+			headBudget:   &rayv1.DisruptionBudget{MinAvailable: ptr.To(intstr.FromInt32(1))},
+			workerBudget: &rayv1.DisruptionBudget{MaxUnavailable: ptr.To(intstr.FromString("25%"))},
+			expectError:  false,
+		},
+		{
+			name: "both minAvailable and maxUnavailable are set on the head group",
+			headBudget: &rayv1.DisruptionBudget{
+				MinAvailable:   ptr.To(intstr.FromInt32(1)),
+				MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+			},
+			expectError:  true,
+			errorMessage: "headGroupSpec has an invalid disruptionBudget: minAvailable and maxUnavailable cannot be both set",
+		},
+		{
+			name:         "negative maxUnavailable",
+			workerBudget: &rayv1.DisruptionBudget{MaxUnavailable: ptr.To(intstr.FromInt32(-1))},
+			expectError:  true,
+			errorMessage: "worker group worker-group-1 has an invalid disruptionBudget: maxUnavailable cannot be negative",
+		},
+		{
+			name:         "invalid percentage",
+			workerBudget: &rayv1.DisruptionBudget{MinAvailable: ptr.To(intstr.FromString("half"))},
+			expectError:  true,
+			errorMessage: "worker group worker-group-1 has an invalid disruptionBudget: invalid minAvailable: invalid value for IntOrString: invalid type: string is not a percentage",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := rayv1.RayClusterSpec{
+				HeadGroupSpec: rayv1.HeadGroupSpec{
+					Template:         podTemplateSpec(nil, nil),
+					DisruptionBudget: tt.headBudget,
+				},
+				WorkerGroupSpecs: []rayv1.WorkerGroupSpec{
+					{
+						GroupName:        "worker-group-1",
+						Template:         podTemplateSpec(nil, nil),
+						DisruptionBudget: tt.workerBudget,
+					},
+				},
+			}
+			err := ValidateRayClusterSpec(&spec, nil)
+			if tt.expectError {
+				require.Error(t, err)
+				assert.EqualError(t, err, tt.errorMessage)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRayClusterSpecVolumeClaimTemplates(t *testing.T) {
+	tests := []struct {
+		policy       *rayv1.VolumeClaimRetentionPolicy
+		name         string
+		errorMessage string
+		templates    []corev1.PersistentVolumeClaim
+		expectError  bool
+	}{
+		{
+			name:        "no volumeClaimTemplates",
+			expectError: false,
+		},
+		{
+			name: "valid volumeClaimTemplates and retention policy",
+			templates: []corev1.PersistentVolumeClaim{
+				{ObjectMeta: metav1.ObjectMeta{Name: "data"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "cache"}},
+			},
+			policy: &rayv1.VolumeClaimRetentionPolicy{
+				WhenPodDeleted:     ptr.To(rayv1.RetainVolumeClaimRetentionPolicy),
+				WhenClusterDeleted: ptr.To(rayv1.DeleteVolumeClaimRetentionPolicy),
+			},
+			expectError: false,
+		},
+		{
+			name:         "empty name",
+			templates:    []corev1.PersistentVolumeClaim{{}},
+			expectError:  true,
+			errorMessage: "worker group worker-group-1 has invalid volumeClaimTemplates: the name of a volumeClaimTemplate cannot be empty",
+		},
+		{
+			name: "duplicated name",
+			templates: []corev1.PersistentVolumeClaim{
+				{ObjectMeta: metav1.ObjectMeta{Name: "data"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "data"}},
+			},
+			expectError:  true,
+			errorMessage: "worker group worker-group-1 has invalid volumeClaimTemplates: volumeClaimTemplate name data is duplicated",
+		},
+		{
+			name:      "invalid retention policy",
+			templates: []corev1.PersistentVolumeClaim{{ObjectMeta: metav1.ObjectMeta{Name: "data"}}},
+			policy: &rayv1.VolumeClaimRetentionPolicy{
+				WhenPodDeleted: ptr.To(rayv1.VolumeClaimRetentionPolicyType("Keep")),
+			},
+			expectError:  true,
+			errorMessage: "worker group worker-group-1 has invalid volumeClaimTemplates: volumeClaimRetentionPolicy.whenPodDeleted Keep is invalid, valid options are Retain or Delete",
+		},
+		{
+			name:      "claims deleted with the Pod but retained with the cluster",
+			templates: []corev1.PersistentVolumeClaim{{ObjectMeta: metav1.ObjectMeta{Name: "data"}}},
+			policy: &rayv1.VolumeClaimRetentionPolicy{
+				WhenClusterDeleted: ptr.To(rayv1.RetainVolumeClaimRetentionPolicy),
+			},
+			expectError:  true,
+			errorMessage: "worker group worker-group-1 has invalid volumeClaimTemplates: volumeClaimRetentionPolicy.whenPodDeleted cannot be Delete when whenClusterDeleted is Retain, because Pods are deleted together with the RayCluster",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := rayv1.RayClusterSpec{
+				HeadGroupSpec: rayv1.HeadGroupSpec{
+					Template: podTemplateSpec(nil, nil),
+				},
+				WorkerGroupSpecs: []rayv1.WorkerGroupSpec{
+					{
+						GroupName:                  "worker-group-1",
+						Template:                   podTemplateSpec(nil, nil),
+						VolumeClaimTemplates:       tt.templates,
+						VolumeClaimRetentionPolicy: tt.policy,
+					},
+				},
+			}
+			err := ValidateRayClusterSpec(&spec, nil)
+			if tt.expectError {
+				require.Error(t, err)
+				assert.EqualError(t, err, tt.errorMessage)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRayClusterSpecIdleSuspendPolicy(t *testing.T) {
+	spec := createBasicRayClusterSpec()
+	spec.IdleSuspendPolicy = &rayv1.IdleSuspendPolicy{IdleTimeoutSeconds: 600}
+	require.NoError(t, ValidateRayClusterSpec(spec, nil))
+
+	spec.IdleSuspendPolicy.IdleTimeoutSeconds = 0
+	assert.EqualError(t, ValidateRayClusterSpec(spec, nil), "idleSuspendPolicy.idleTimeoutSeconds must be a positive integer")
+}
+
+func TestValidateRayClusterSpecSuspendSchedule(t *testing.T) {
+	tests := []struct {
+		schedule    *rayv1.SuspendSchedule
+		name        string
+		expectError string
+	}{
+		{
+			name:     "suspend and resume on weekdays in a time zone",
+			schedule: &rayv1.SuspendSchedule{Suspend: "0 20 * * 1-5", Resume: "0 7 * * 1-5", TimeZone: ptr.To("America/New_York")},
+		},
+		{
+			name:     "only a suspend schedule",
+			schedule: &rayv1.SuspendSchedule{Suspend: "@daily"},
+		},
+		{
+			name:        "no schedule",
+			schedule:    &rayv1.SuspendSchedule{TimeZone: ptr.To("UTC")},
+			expectError: "invalid suspendSchedule: at least one of suspend and resume should be set",
+		},
+		{
+			name:        "invalid cron schedule",
+			schedule:    &rayv1.SuspendSchedule{Suspend: "0 20 * *"},
+			expectError: "invalid suspendSchedule: suspend is not a valid cron schedule",
+		},
+		{
+			name:        "time zone prefix in the cron schedule",
+			schedule:    &rayv1.SuspendSchedule{Resume: "CRON_TZ=Europe/Paris 0 7 * * *"},
+			expectError: "invalid suspendSchedule: resume is not a valid cron schedule",
+		},
+		{
+			name:        "invalid time zone",
+			schedule:    &rayv1.SuspendSchedule{Suspend: "0 20 * * *", TimeZone: ptr.To("Mars/Olympus_Mons")},
+			expectError: "invalid suspendSchedule: timeZone is invalid",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := createBasicRayClusterSpec()
+			spec.SuspendSchedule = tc.schedule
+			err := ValidateRayClusterSpec(spec, nil)
+			if tc.expectError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateRayClusterSpecCertificateIssuer(t *testing.T) {
+	tests := []struct {
+		issuer      *rayv1.CertificateIssuer
+		name        string
+		expectError string
+	}{
+		{
+			name:   "issuer with the default kind and group",
+			issuer: &rayv1.CertificateIssuer{Name: "shared-ca"},
+		},
+		{
+			name:   "cluster issuer with a trust bundle",
+			issuer: &rayv1.CertificateIssuer{Name: "corporate-ca", Kind: "ClusterIssuer", TrustBundle: &corev1.ConfigMapKeySelector{Key: "ca.crt"}},
+		},
+		{
+			name:   "external issuer of another group",
+			issuer: &rayv1.CertificateIssuer{Name: "pca", Kind: "AWSPCAClusterIssuer", Group: "awspca.cert-manager.io"},
+		},
+		{
+			name:        "no name",
+			issuer:      &rayv1.CertificateIssuer{Kind: "ClusterIssuer"},
+			expectError: "invalid securityOptions.certificateIssuer: name should be set",
+		},
+		{
+			name:        "unknown kind of cert-manager issuer",
+			issuer:      &rayv1.CertificateIssuer{Name: "vault", Kind: "VaultIssuer"},
+			expectError: "invalid securityOptions.certificateIssuer: kind should be Issuer or ClusterIssuer",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := createBasicRayClusterSpec()
+			spec.SecurityOptions = &rayv1.SecurityOptions{CertificateIssuer: tc.issuer}
+			err := ValidateRayClusterSpec(spec, nil)
+			if tc.expectError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateRayClusterSpecDashboardPathTemplate(t *testing.T) {
+	tests := []struct {
+		name        string
+		template    string
+		expectError string
+	}{
+		{
+			name:     "path template with placeholders",
+			template: "/ray/{namespace}/{name}",
+		},
+		{
+			name:     "path template with adjacent placeholders",
+			template: "/{namespace}-{name}",
+		},
+		{
+			name:        "relative path template",
+			template:    "ray/{namespace}/{name}",
+			expectError: "invalid ray.io/dashboard-path-template annotation: path template should start with /",
+		},
+		{
+			name:        "path template with a trailing slash",
+			template:    "/ray/{namespace}/{name}/",
+			expectError: "invalid ray.io/dashboard-path-template annotation: path template should not end with /",
+		},
+		{
+			name:        "path template without the namespace",
+			template:    "/ray/{name}",
+			expectError: "invalid ray.io/dashboard-path-template annotation: path template should contain the {namespace} placeholder",
+		},
+		{
+			name:        "path template without the name",
+			template:    "/ray/{namespace}",
+			expectError: "invalid ray.io/dashboard-path-template annotation: path template should contain the {name} placeholder",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := createBasicRayClusterSpec()
+			err := ValidateRayClusterSpec(spec, map[string]string{RayDashboardPathTemplateAnnotationKey: tc.template})
+			if tc.expectError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateRayClusterSpecAuthProxyListeners(t *testing.T) {
+	tests := []struct {
+		name            string
+		securityOptions *rayv1.SecurityOptions
+		annotations     map[string]string
+		expectError     string
+	}{
+		{
+			name:            "listeners with the auth proxy",
+			securityOptions: &rayv1.SecurityOptions{AuthProxy: ptr.To(true), ServeAuthProxy: ptr.To(true), ClientAuthProxy: ptr.To(true)},
+		},
+		{
+			name:            "listeners with the secure trusted network annotation",
+			securityOptions: &rayv1.SecurityOptions{ServeAuthProxy: ptr.To(true), ClientAuthProxy: ptr.To(true)},
+			annotations:     map[string]string{EnableSecureTrustedNetworkAnnotationKey: "true"},
+		},
+		{
+			name:            "disabled listeners without the auth proxy",
+			securityOptions: &rayv1.SecurityOptions{ServeAuthProxy: ptr.To(false), ClientAuthProxy: ptr.To(false)},
+		},
+		{
+			name:            "serve listener without the auth proxy",
+			securityOptions: &rayv1.SecurityOptions{ServeAuthProxy: ptr.To(true)},
+			expectError:     "securityOptions.serveAuthProxy requires securityOptions.authProxy to be enabled",
+		},
+		{
+			name:            "client listener with the auth proxy disabled",
+			securityOptions: &rayv1.SecurityOptions{AuthProxy: ptr.To(false), ClientAuthProxy: ptr.To(true)},
+			annotations:     map[string]string{EnableSecureTrustedNetworkAnnotationKey: "true"},
+			expectError:     "securityOptions.clientAuthProxy requires securityOptions.authProxy to be enabled",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := createBasicRayClusterSpec()
+			spec.SecurityOptions = tc.securityOptions
+			err := ValidateRayClusterSpec(spec, tc.annotations)
+			if tc.expectError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateRayClusterSpecNetworkPolicy(t *testing.T) {
+	namespacePeer := networkingv1.NetworkPolicyPeer{
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{corev1.LabelMetadataName: "notebooks"}},
+	}
+	tests := []struct {
+		name        string
+		expectError string
+		rule        networkingv1.NetworkPolicyIngressRule
+	}{
+		{
+			name: "namespace peer with ports",
+			rule: networkingv1.NetworkPolicyIngressRule{
+				From: []networkingv1.NetworkPolicyPeer{namespacePeer},
+				Ports: []networkingv1.NetworkPolicyPort{
+					{Protocol: ptr.To(corev1.ProtocolTCP), Port: ptr.To(intstr.FromInt32(10001))},
+					{Port: ptr.To(intstr.FromString("metrics"))},
+					{Port: ptr.To(intstr.FromInt32(8000)), EndPort: ptr.To(int32(8010))},
+				},
+			},
+		},
+		{
+			name: "CIDR peer",
+			rule: networkingv1.NetworkPolicyIngressRule{
+				From: []networkingv1.NetworkPolicyPeer{
+					{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0/8", Except: []string{"10.1.0.0/16"}}},
+				},
+			},
+		},
+		{
+			name:        "rule without peers",
+			rule:        networkingv1.NetworkPolicyIngressRule{},
+			expectError: "invalid networkPolicy: headIngress[0]: from should list at least one peer",
+		},
+		{
+			name:        "empty peer",
+			rule:        networkingv1.NetworkPolicyIngressRule{From: []networkingv1.NetworkPolicyPeer{{}}},
+			expectError: "one of podSelector, namespaceSelector or ipBlock should be set",
+		},
+		{
+			name: "invalid CIDR",
+			rule: networkingv1.NetworkPolicyIngressRule{
+				From: []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0"}}},
+			},
+			expectError: `ipBlock.cidr "10.0.0.0" is not a valid CIDR`,
+		},
+		{
+			name: "except outside of the CIDR",
+			rule: networkingv1.NetworkPolicyIngressRule{
+				From: []networkingv1.NetworkPolicyPeer{
+					{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0/16", Except: []string{"192.168.0.0/24"}}},
+				},
+			},
+			expectError: `ipBlock.except "192.168.0.0/24" is not within ipBlock.cidr "10.0.0.0/16"`,
+		},
+		{
+			name: "CIDR together with a selector",
+			rule: networkingv1.NetworkPolicyIngressRule{
+				From: []networkingv1.NetworkPolicyPeer{
+					{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0/8"}, NamespaceSelector: namespacePeer.NamespaceSelector},
+				},
+			},
+			expectError: "ipBlock cannot be set together with podSelector or namespaceSelector",
+		},
+		{
+			name: "invalid selector",
+			rule: networkingv1.NetworkPolicyIngressRule{
+				From: []networkingv1.NetworkPolicyPeer{
+					{PodSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Like"}}}},
+				},
+			},
+			expectError: "invalid podSelector",
+		},
+		{
+			name: "invalid protocol",
+			rule: networkingv1.NetworkPolicyIngressRule{
+				From:  []networkingv1.NetworkPolicyPeer{namespacePeer},
+				Ports: []networkingv1.NetworkPolicyPort{{Protocol: ptr.To(corev1.Protocol("ICMP"))}},
+			},
+			expectError: `ports[0]: unsupported protocol "ICMP"`,
+		},
+		{
+			name: "port out of range",
+			rule: networkingv1.NetworkPolicyIngressRule{
+				From:  []networkingv1.NetworkPolicyPeer{namespacePeer},
+				Ports: []networkingv1.NetworkPolicyPort{{Port: ptr.To(intstr.FromInt32(70000))}},
+			},
+			expectError: "invalid port 70000",
+		},
+		{
+			name: "end port lower than the port",
+			rule: networkingv1.NetworkPolicyIngressRule{
+				From:  []networkingv1.NetworkPolicyPeer{namespacePeer},
+				Ports: []networkingv1.NetworkPolicyPort{{Port: ptr.To(intstr.FromInt32(8010)), EndPort: ptr.To(int32(8000))}},
+			},
+			expectError: "endPort 8000 should not be lower than port 8010",
+		},
+		{
+			name: "end port with a named port",
+			rule: networkingv1.NetworkPolicyIngressRule{
+				From:  []networkingv1.NetworkPolicyPeer{namespacePeer},
+				Ports: []networkingv1.NetworkPolicyPort{{Port: ptr.To(intstr.FromString("metrics")), EndPort: ptr.To(int32(8080))}},
+			},
+			expectError: "endPort requires a numeric port",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := createBasicRayClusterSpec()
+			spec.NetworkPolicy = &rayv1.NetworkPolicyOptions{HeadIngress: []networkingv1.NetworkPolicyIngressRule{tc.rule}}
+			err := ValidateRayClusterSpec(spec, nil)
+			if tc.expectError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectError)
+			}
+		})
+	}
+
+	// The rules of the worker Pods are validated too.
+	spec := createBasicRayClusterSpec()
+	spec.NetworkPolicy = &rayv1.NetworkPolicyOptions{WorkerIngress: []networkingv1.NetworkPolicyIngressRule{{}}}
+	require.ErrorContains(t, ValidateRayClusterSpec(spec, nil), "invalid networkPolicy: workerIngress[0]")
+
+	// The egress allowlist accepts rules with only ports, but not empty rules.
+	spec.NetworkPolicy = &rayv1.NetworkPolicyOptions{Egress: &rayv1.NetworkPolicyEgress{
+		Allow: []networkingv1.NetworkPolicyEgressRule{{Ports: []networkingv1.NetworkPolicyPort{{Port: ptr.To(intstr.FromInt32(443))}}}},
+	}}
+	require.NoError(t, ValidateRayClusterSpec(spec, nil))
+	spec.NetworkPolicy.Egress.Allow = append(spec.NetworkPolicy.Egress.Allow, networkingv1.NetworkPolicyEgressRule{})
+	require.ErrorContains(t, ValidateRayClusterSpec(spec, nil), "egress.allow[1]: to or ports should list at least one peer or port")
+}
+
+func TestValidateRayClusterSpecDashboardAccessPolicy(t *testing.T) {
+	tests := []struct {
+		policy      *rayv1.DashboardAccessPolicy
+		name        string
+		expectError string
+	}{
+		{
+			name:   "users and groups",
+			policy: &rayv1.DashboardAccessPolicy{Users: []string{"alice"}, Groups: []string{"ml-team"}},
+		},
+		{
+			name:   "custom resource attributes",
+			policy: &rayv1.DashboardAccessPolicy{ResourceAttributes: &rayv1.DashboardResourceAttributes{Resource: "services", Verb: "proxy"}},
+		},
+		{
+			name: "resource attributes together with groups",
+			policy: &rayv1.DashboardAccessPolicy{
+				Groups:             []string{"ml-team"},
+				ResourceAttributes: &rayv1.DashboardResourceAttributes{Resource: "services"},
+			},
+			expectError: "invalid securityOptions.dashboardAccessPolicy: resourceAttributes cannot be set together with users or groups",
+		},
+		{
+			name:        "resource attributes without a resource",
+			policy:      &rayv1.DashboardAccessPolicy{ResourceAttributes: &rayv1.DashboardResourceAttributes{Verb: "get"}},
+			expectError: "invalid securityOptions.dashboardAccessPolicy: resourceAttributes.resource should be set",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := createBasicRayClusterSpec()
+			spec.SecurityOptions = &rayv1.SecurityOptions{DashboardAccessPolicy: tc.policy}
+			err := ValidateRayClusterSpec(spec, nil)
+			if tc.expectError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectError)
+			}
+		})
+	}
+}
+
 func TestValidateRayJobStatus(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -736,6 +1368,28 @@ func TestValidateRayJobSpec(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "the RayClusterSpec of a RayJob cannot have an idleSuspendPolicy",
+			spec: rayv1.RayJobSpec{
+				RayClusterSpec: func() *rayv1.RayClusterSpec {
+					spec := createBasicRayClusterSpec()
+					spec.IdleSuspendPolicy = &rayv1.IdleSuspendPolicy{IdleTimeoutSeconds: 600}
+					return spec
+				}(),
+			},
+			expectError: true,
+		},
+		{
+			name: "the RayClusterSpec of a RayJob cannot have a suspendSchedule",
+			spec: rayv1.RayJobSpec{
+				RayClusterSpec: func() *rayv1.RayClusterSpec {
+					spec := createBasicRayClusterSpec()
+					spec.SuspendSchedule = &rayv1.SuspendSchedule{Suspend: "0 20 * * *"}
+					return spec
+				}(),
+			},
+			expectError: true,
+		},
 		{
 			name: "the ClusterSelector mode doesn't support the suspend operation",
 			spec: rayv1.RayJobSpec{
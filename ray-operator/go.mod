@@ -15,6 +15,7 @@ require (
 	github.com/orcaman/concurrent-map/v2 v2.0.1
 	github.com/pkg/errors v0.9.1
 	github.com/prometheus/client_golang v1.23.0
+	github.com/robfig/cron/v3 v3.0.1
 	github.com/stretchr/testify v1.11.0
 	go.uber.org/mock v0.5.2
 	go.uber.org/zap v1.27.0
@@ -9,11 +9,14 @@ import (
 	// Add cert-manager scheme
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/go-logr/zapr"
+	configv1 "github.com/openshift/api/config/v1"
 	routev1 "github.com/openshift/api/route/v1"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"gopkg.in/natefinch/lumberjack.v2"
 	batchv1 "k8s.io/api/batch/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
@@ -50,6 +53,7 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(rayv1.AddToScheme(scheme))
 	utilruntime.Must(routev1.Install(scheme))
+	utilruntime.Must(configv1.Install(scheme))
 	utilruntime.Must(batchv1.AddToScheme(scheme))
 	utilruntime.Must(configapi.AddToScheme(scheme))
 	utilruntime.Must(certmanagerv1.AddToScheme(scheme))
@@ -73,6 +77,7 @@ func main() {
 	var featureGates string
 	var enableBatchScheduler bool
 	var batchScheduler string
+	var batchSchedulers string
 	var enableMetrics bool
 
 	// TODO: remove flag-based config once Configuration API graduates to v1.
@@ -100,6 +105,8 @@ func main() {
 		"(Deprecated) Enable batch scheduler. Currently is volcano, which supports gang scheduler policy. Please use --batch-scheduler instead.")
 	flag.StringVar(&batchScheduler, "batch-scheduler", "",
 		"Batch scheduler name, supported values are volcano and yunikorn.")
+	flag.StringVar(&batchSchedulers, "batch-schedulers", "",
+		"Comma-separated names of the additional batch schedulers that RayClusters can select with the ray.io/scheduler-name label.")
 	flag.StringVar(&configFile, "config", "", "Path to structured config file. Flags are ignored if config file is set.")
 	flag.BoolVar(&useKubernetesProxy, "use-kubernetes-proxy", false,
 		"Use Kubernetes proxy subresource when connecting to the Ray Head node.")
@@ -132,6 +139,11 @@ func main() {
 		config.LogStdoutEncoder = logStdoutEncoder
 		config.EnableBatchScheduler = enableBatchScheduler
 		config.BatchScheduler = batchScheduler
+		for _, name := range strings.Split(batchSchedulers, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				config.BatchSchedulers = append(config.BatchSchedulers, name)
+			}
+		}
 		config.UseKubernetesProxy = useKubernetesProxy
 		config.DeleteRayJobAfterJobFinishes = os.Getenv(utils.DELETE_RAYJOB_CR_AFTER_JOB_FINISHES) == "true"
 		config.EnableMetrics = enableMetrics
@@ -184,6 +196,22 @@ func main() {
 		exitOnError(err, "batch scheduler configs validation failed")
 	}
 
+	// validate the default issuer of the mTLS certificates,
+	// exit with error if it is invalid.
+	if config.MTLSCertificateIssuer != nil {
+		if err := utils.ValidateCertificateIssuer(config.MTLSCertificateIssuer); err != nil {
+			exitOnError(err, "mTLS certificate issuer config validation failed")
+		}
+	}
+
+	// validate the path template of the dashboard HTTPRoutes,
+	// exit with error if it is invalid.
+	if config.DashboardGateway != nil && config.DashboardGateway.PathTemplate != "" {
+		if err := utils.ValidateDashboardPathTemplate(config.DashboardGateway.PathTemplate); err != nil {
+			exitOnError(err, "dashboard gateway config validation failed")
+		}
+	}
+
 	if err := utilfeature.DefaultMutableFeatureGate.Set(featureGates); err != nil {
 		exitOnError(err, "Unable to set flag gates for known features")
 	}
@@ -256,8 +284,12 @@ func main() {
 		WorkerSidecarContainers:  config.WorkerSidecarContainers,
 		IsOpenShift:              utils.GetClusterType(),
 		RayClusterMetricsManager: rayClusterMetricsManager,
+		MTLSCertificateIssuer:    config.MTLSCertificateIssuer,
+		DashboardGateway:         config.DashboardGateway,
+		AuthProxy:                config.AuthProxy,
 	}
-	exitOnError(ray.NewReconciler(ctx, mgr, rayClusterOptions, config).SetupWithManager(mgr, config.ReconcileConcurrency),
+	rayClusterReconciler := ray.NewReconciler(ctx, mgr, rayClusterOptions, config)
+	exitOnError(rayClusterReconciler.SetupWithManager(mgr, config.ReconcileConcurrency),
 		"unable to create controller", "controller", "RayCluster")
 
 	exitOnError(ray.NewRayServiceReconciler(ctx, mgr, config).SetupWithManager(mgr, config.ReconcileConcurrency),
@@ -265,17 +297,18 @@ func main() {
 
 	rayJobOptions := ray.RayJobReconcilerOptions{
 		RayJobMetricsManager: rayJobMetricsManager,
+		BatchSchedulerMgr:    rayClusterReconciler.BatchSchedulerMgr,
 	}
 	exitOnError(ray.NewRayJobReconciler(ctx, mgr, rayJobOptions, config).SetupWithManager(mgr, config.ReconcileConcurrency),
 		"unable to create controller", "controller", "RayJob")
 
 	// Setup MTLS controller
-	mtlsController := ray.NewRayClusterMTLSController(mgr.GetClient(), mgr.GetScheme(), &config)
+	mtlsController := ray.NewRayClusterMTLSController(mgr.GetClient(), mgr.GetScheme(), mgr.GetEventRecorderFor("raycluster-mtls-controller"), mgr.GetRESTMapper(), &config)
 	exitOnError(mtlsController.SetupWithManager(mgr),
 		"unable to create controller", "controller", "RayClusterMTLS")
 
 	// NetworkPolicy controller (always registered, uses annotation-based activation)
-	exitOnError(ray.NewNetworkPolicyController(mgr).SetupWithManager(mgr),
+	exitOnError(ray.NewNetworkPolicyController(mgr, &config).SetupWithManager(mgr),
 		"unable to create controller", "controller", "NetworkPolicy")
 	setupLog.Info("NetworkPolicy controller registered (annotation-based activation)")
 	// Setup AuthenticationController
@@ -305,8 +338,16 @@ func cacheSelectors() (map[client.Object]cache.ByObject, error) {
 	}
 	selector := labels.NewSelector().Add(*label)
 
+	// The NetworkPolicy controller only watches the endpoints of the Kubernetes API, which are in the default
+	// namespace even if KubeRay only watches other namespaces.
+	kubernetesAPISelector := labels.SelectorFromSet(labels.Set{discoveryv1.LabelServiceName: "kubernetes"})
+
 	return map[client.Object]cache.ByObject{
 		&batchv1.Job{}: {Label: selector},
+		&discoveryv1.EndpointSlice{}: {
+			Namespaces: map[string]cache.Config{metav1.NamespaceDefault: {}},
+			Label:      kubernetesAPISelector,
+		},
 	}, nil
 }
 
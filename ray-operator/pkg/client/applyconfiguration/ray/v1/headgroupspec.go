@@ -10,11 +10,14 @@ import (
 // HeadGroupSpecApplyConfiguration represents a declarative configuration of the HeadGroupSpec type for use
 // with apply.
 type HeadGroupSpecApplyConfiguration struct {
-	Template       *corev1.PodTemplateSpecApplyConfiguration `json:"template,omitempty"`
-	HeadService    *apicorev1.Service                        `json:"headService,omitempty"`
-	EnableIngress  *bool                                     `json:"enableIngress,omitempty"`
-	RayStartParams map[string]string                         `json:"rayStartParams,omitempty"`
-	ServiceType    *apicorev1.ServiceType                    `json:"serviceType,omitempty"`
+	Template                   *corev1.PodTemplateSpecApplyConfiguration     `json:"template,omitempty"`
+	HeadService                *apicorev1.Service                            `json:"headService,omitempty"`
+	EnableIngress              *bool                                         `json:"enableIngress,omitempty"`
+	RayStartParams             map[string]string                             `json:"rayStartParams,omitempty"`
+	ServiceType                *apicorev1.ServiceType                        `json:"serviceType,omitempty"`
+	DisruptionBudget           *DisruptionBudgetApplyConfiguration           `json:"disruptionBudget,omitempty"`
+	VolumeClaimTemplates       []apicorev1.PersistentVolumeClaim             `json:"volumeClaimTemplates,omitempty"`
+	VolumeClaimRetentionPolicy *VolumeClaimRetentionPolicyApplyConfiguration `json:"volumeClaimRetentionPolicy,omitempty"`
 }
 
 // HeadGroupSpecApplyConfiguration constructs a declarative configuration of the HeadGroupSpec type for use with
@@ -68,3 +71,29 @@ func (b *HeadGroupSpecApplyConfiguration) WithServiceType(value apicorev1.Servic
 	b.ServiceType = &value
 	return b
 }
+
+// WithDisruptionBudget sets the DisruptionBudget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisruptionBudget field is set to the value of the last call.
+func (b *HeadGroupSpecApplyConfiguration) WithDisruptionBudget(value *DisruptionBudgetApplyConfiguration) *HeadGroupSpecApplyConfiguration {
+	b.DisruptionBudget = value
+	return b
+}
+
+// WithVolumeClaimTemplates adds the given value to the VolumeClaimTemplates field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VolumeClaimTemplates field.
+func (b *HeadGroupSpecApplyConfiguration) WithVolumeClaimTemplates(values ...apicorev1.PersistentVolumeClaim) *HeadGroupSpecApplyConfiguration {
+	for i := range values {
+		b.VolumeClaimTemplates = append(b.VolumeClaimTemplates, values[i])
+	}
+	return b
+}
+
+// WithVolumeClaimRetentionPolicy sets the VolumeClaimRetentionPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeClaimRetentionPolicy field is set to the value of the last call.
+func (b *HeadGroupSpecApplyConfiguration) WithVolumeClaimRetentionPolicy(value *VolumeClaimRetentionPolicyApplyConfiguration) *HeadGroupSpecApplyConfiguration {
+	b.VolumeClaimRetentionPolicy = value
+	return b
+}
@@ -6,8 +6,12 @@ package v1
 // with apply.
 type RayClusterSpecApplyConfiguration struct {
 	Suspend                  *bool                                       `json:"suspend,omitempty"`
+	IdleSuspendPolicy        *IdleSuspendPolicyApplyConfiguration        `json:"idleSuspendPolicy,omitempty"`
+	SuspendSchedule          *SuspendScheduleApplyConfiguration          `json:"suspendSchedule,omitempty"`
 	ManagedBy                *string                                     `json:"managedBy,omitempty"`
 	AutoscalerOptions        *AutoscalerOptionsApplyConfiguration        `json:"autoscalerOptions,omitempty"`
+	SecurityOptions          *SecurityOptionsApplyConfiguration          `json:"securityOptions,omitempty"`
+	NetworkPolicy            *NetworkPolicyOptionsApplyConfiguration     `json:"networkPolicy,omitempty"`
 	HeadServiceAnnotations   map[string]string                           `json:"headServiceAnnotations,omitempty"`
 	EnableInTreeAutoscaling  *bool                                       `json:"enableInTreeAutoscaling,omitempty"`
 	GcsFaultToleranceOptions *GcsFaultToleranceOptionsApplyConfiguration `json:"gcsFaultToleranceOptions,omitempty"`
@@ -30,6 +34,22 @@ func (b *RayClusterSpecApplyConfiguration) WithSuspend(value bool) *RayClusterSp
 	return b
 }
 
+// WithIdleSuspendPolicy sets the IdleSuspendPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IdleSuspendPolicy field is set to the value of the last call.
+func (b *RayClusterSpecApplyConfiguration) WithIdleSuspendPolicy(value *IdleSuspendPolicyApplyConfiguration) *RayClusterSpecApplyConfiguration {
+	b.IdleSuspendPolicy = value
+	return b
+}
+
+// WithSuspendSchedule sets the SuspendSchedule field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SuspendSchedule field is set to the value of the last call.
+func (b *RayClusterSpecApplyConfiguration) WithSuspendSchedule(value *SuspendScheduleApplyConfiguration) *RayClusterSpecApplyConfiguration {
+	b.SuspendSchedule = value
+	return b
+}
+
 // WithManagedBy sets the ManagedBy field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ManagedBy field is set to the value of the last call.
@@ -46,6 +66,22 @@ func (b *RayClusterSpecApplyConfiguration) WithAutoscalerOptions(value *Autoscal
 	return b
 }
 
+// WithSecurityOptions sets the SecurityOptions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecurityOptions field is set to the value of the last call.
+func (b *RayClusterSpecApplyConfiguration) WithSecurityOptions(value *SecurityOptionsApplyConfiguration) *RayClusterSpecApplyConfiguration {
+	b.SecurityOptions = value
+	return b
+}
+
+// WithNetworkPolicy sets the NetworkPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NetworkPolicy field is set to the value of the last call.
+func (b *RayClusterSpecApplyConfiguration) WithNetworkPolicy(value *NetworkPolicyOptionsApplyConfiguration) *RayClusterSpecApplyConfiguration {
+	b.NetworkPolicy = value
+	return b
+}
+
 // WithHeadServiceAnnotations puts the entries into the HeadServiceAnnotations field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, the entries provided by each call will be put on the HeadServiceAnnotations field,
@@ -28,6 +28,13 @@ type RayClusterStatusApplyConfiguration struct {
 	DesiredWorkerReplicas   *int32                                                  `json:"desiredWorkerReplicas,omitempty"`
 	MinWorkerReplicas       *int32                                                  `json:"minWorkerReplicas,omitempty"`
 	MaxWorkerReplicas       *int32                                                  `json:"maxWorkerReplicas,omitempty"`
+	UpdatedWorkerReplicas   *int32                                                  `json:"updatedWorkerReplicas,omitempty"`
+	DrainingWorkerReplicas  *int32                                                  `json:"drainingWorkerReplicas,omitempty"`
+	WorkerGroupStatuses     []WorkerGroupStatusApplyConfiguration                   `json:"workerGroupStatuses,omitempty"`
+	IdleSince               *metav1.Time                                            `json:"idleSince,omitempty"`
+	NextScheduledAction     *ScheduledActionApplyConfiguration                      `json:"nextScheduledAction,omitempty"`
+	Security                *SecurityStatusApplyConfiguration                       `json:"security,omitempty"`
+	Certificates            *CertificatesStatusApplyConfiguration                   `json:"certificates,omitempty"`
 	ObservedGeneration      *int64                                                  `json:"observedGeneration,omitempty"`
 }
 
@@ -182,6 +189,67 @@ func (b *RayClusterStatusApplyConfiguration) WithMaxWorkerReplicas(value int32)
 	return b
 }
 
+// WithUpdatedWorkerReplicas sets the UpdatedWorkerReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpdatedWorkerReplicas field is set to the value of the last call.
+func (b *RayClusterStatusApplyConfiguration) WithUpdatedWorkerReplicas(value int32) *RayClusterStatusApplyConfiguration {
+	b.UpdatedWorkerReplicas = &value
+	return b
+}
+
+// WithDrainingWorkerReplicas sets the DrainingWorkerReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DrainingWorkerReplicas field is set to the value of the last call.
+func (b *RayClusterStatusApplyConfiguration) WithDrainingWorkerReplicas(value int32) *RayClusterStatusApplyConfiguration {
+	b.DrainingWorkerReplicas = &value
+	return b
+}
+
+// WithWorkerGroupStatuses adds the given value to the WorkerGroupStatuses field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the WorkerGroupStatuses field.
+func (b *RayClusterStatusApplyConfiguration) WithWorkerGroupStatuses(values ...*WorkerGroupStatusApplyConfiguration) *RayClusterStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithWorkerGroupStatuses")
+		}
+		b.WorkerGroupStatuses = append(b.WorkerGroupStatuses, *values[i])
+	}
+	return b
+}
+
+// WithIdleSince sets the IdleSince field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IdleSince field is set to the value of the last call.
+func (b *RayClusterStatusApplyConfiguration) WithIdleSince(value metav1.Time) *RayClusterStatusApplyConfiguration {
+	b.IdleSince = &value
+	return b
+}
+
+// WithNextScheduledAction sets the NextScheduledAction field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NextScheduledAction field is set to the value of the last call.
+func (b *RayClusterStatusApplyConfiguration) WithNextScheduledAction(value *ScheduledActionApplyConfiguration) *RayClusterStatusApplyConfiguration {
+	b.NextScheduledAction = value
+	return b
+}
+
+// WithSecurity sets the Security field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Security field is set to the value of the last call.
+func (b *RayClusterStatusApplyConfiguration) WithSecurity(value *SecurityStatusApplyConfiguration) *RayClusterStatusApplyConfiguration {
+	b.Security = value
+	return b
+}
+
+// WithCertificates sets the Certificates field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Certificates field is set to the value of the last call.
+func (b *RayClusterStatusApplyConfiguration) WithCertificates(value *CertificatesStatusApplyConfiguration) *RayClusterStatusApplyConfiguration {
+	b.Certificates = value
+	return b
+}
+
 // WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ObservedGeneration field is set to the value of the last call.
@@ -3,22 +3,28 @@
 package v1
 
 import (
+	apicorev1 "k8s.io/api/core/v1"
 	corev1 "k8s.io/client-go/applyconfigurations/core/v1"
 )
 
 // WorkerGroupSpecApplyConfiguration represents a declarative configuration of the WorkerGroupSpec type for use
 // with apply.
 type WorkerGroupSpecApplyConfiguration struct {
-	Suspend            *bool                                     `json:"suspend,omitempty"`
-	GroupName          *string                                   `json:"groupName,omitempty"`
-	Replicas           *int32                                    `json:"replicas,omitempty"`
-	MinReplicas        *int32                                    `json:"minReplicas,omitempty"`
-	MaxReplicas        *int32                                    `json:"maxReplicas,omitempty"`
-	IdleTimeoutSeconds *int32                                    `json:"idleTimeoutSeconds,omitempty"`
-	RayStartParams     map[string]string                         `json:"rayStartParams,omitempty"`
-	Template           *corev1.PodTemplateSpecApplyConfiguration `json:"template,omitempty"`
-	ScaleStrategy      *ScaleStrategyApplyConfiguration          `json:"scaleStrategy,omitempty"`
-	NumOfHosts         *int32                                    `json:"numOfHosts,omitempty"`
+	Suspend                    *bool                                         `json:"suspend,omitempty"`
+	GroupName                  *string                                       `json:"groupName,omitempty"`
+	Replicas                   *int32                                        `json:"replicas,omitempty"`
+	MinReplicas                *int32                                        `json:"minReplicas,omitempty"`
+	MaxReplicas                *int32                                        `json:"maxReplicas,omitempty"`
+	IdleTimeoutSeconds         *int32                                        `json:"idleTimeoutSeconds,omitempty"`
+	RayStartParams             map[string]string                             `json:"rayStartParams,omitempty"`
+	Template                   *corev1.PodTemplateSpecApplyConfiguration     `json:"template,omitempty"`
+	ScaleStrategy              *ScaleStrategyApplyConfiguration              `json:"scaleStrategy,omitempty"`
+	NumOfHosts                 *int32                                        `json:"numOfHosts,omitempty"`
+	UpdateStrategy             *WorkerGroupUpdateStrategyApplyConfiguration  `json:"updateStrategy,omitempty"`
+	DisruptionBudget           *DisruptionBudgetApplyConfiguration           `json:"disruptionBudget,omitempty"`
+	DrainTimeoutSeconds        *int32                                        `json:"drainTimeoutSeconds,omitempty"`
+	VolumeClaimTemplates       []apicorev1.PersistentVolumeClaim             `json:"volumeClaimTemplates,omitempty"`
+	VolumeClaimRetentionPolicy *VolumeClaimRetentionPolicyApplyConfiguration `json:"volumeClaimRetentionPolicy,omitempty"`
 }
 
 // WorkerGroupSpecApplyConfiguration constructs a declarative configuration of the WorkerGroupSpec type for use with
@@ -112,3 +118,45 @@ func (b *WorkerGroupSpecApplyConfiguration) WithNumOfHosts(value int32) *WorkerG
 	b.NumOfHosts = &value
 	return b
 }
+
+// WithUpdateStrategy sets the UpdateStrategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpdateStrategy field is set to the value of the last call.
+func (b *WorkerGroupSpecApplyConfiguration) WithUpdateStrategy(value *WorkerGroupUpdateStrategyApplyConfiguration) *WorkerGroupSpecApplyConfiguration {
+	b.UpdateStrategy = value
+	return b
+}
+
+// WithDisruptionBudget sets the DisruptionBudget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisruptionBudget field is set to the value of the last call.
+func (b *WorkerGroupSpecApplyConfiguration) WithDisruptionBudget(value *DisruptionBudgetApplyConfiguration) *WorkerGroupSpecApplyConfiguration {
+	b.DisruptionBudget = value
+	return b
+}
+
+// WithDrainTimeoutSeconds sets the DrainTimeoutSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DrainTimeoutSeconds field is set to the value of the last call.
+func (b *WorkerGroupSpecApplyConfiguration) WithDrainTimeoutSeconds(value int32) *WorkerGroupSpecApplyConfiguration {
+	b.DrainTimeoutSeconds = &value
+	return b
+}
+
+// WithVolumeClaimTemplates adds the given value to the VolumeClaimTemplates field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VolumeClaimTemplates field.
+func (b *WorkerGroupSpecApplyConfiguration) WithVolumeClaimTemplates(values ...apicorev1.PersistentVolumeClaim) *WorkerGroupSpecApplyConfiguration {
+	for i := range values {
+		b.VolumeClaimTemplates = append(b.VolumeClaimTemplates, values[i])
+	}
+	return b
+}
+
+// WithVolumeClaimRetentionPolicy sets the VolumeClaimRetentionPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeClaimRetentionPolicy field is set to the value of the last call.
+func (b *WorkerGroupSpecApplyConfiguration) WithVolumeClaimRetentionPolicy(value *VolumeClaimRetentionPolicyApplyConfiguration) *WorkerGroupSpecApplyConfiguration {
+	b.VolumeClaimRetentionPolicy = value
+	return b
+}
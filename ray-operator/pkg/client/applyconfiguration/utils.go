@@ -20,12 +20,28 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &rayv1.AppStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("AutoscalerOptions"):
 		return &rayv1.AutoscalerOptionsApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("CertificateIssuer"):
+		return &rayv1.CertificateIssuerApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("CertificatesStatus"):
+		return &rayv1.CertificatesStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("DashboardAccessPolicy"):
+		return &rayv1.DashboardAccessPolicyApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("DashboardResourceAttributes"):
+		return &rayv1.DashboardResourceAttributesApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("DisruptionBudget"):
+		return &rayv1.DisruptionBudgetApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("GcsFaultToleranceOptions"):
 		return &rayv1.GcsFaultToleranceOptionsApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HeadGroupSpec"):
 		return &rayv1.HeadGroupSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HeadInfo"):
 		return &rayv1.HeadInfoApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IdleSuspendPolicy"):
+		return &rayv1.IdleSuspendPolicyApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("NetworkPolicyEgress"):
+		return &rayv1.NetworkPolicyEgressApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("NetworkPolicyOptions"):
+		return &rayv1.NetworkPolicyOptionsApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RayCluster"):
 		return &rayv1.RayClusterApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RayClusterSpec"):
@@ -52,14 +68,30 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &rayv1.RayServiceUpgradeStrategyApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RedisCredential"):
 		return &rayv1.RedisCredentialApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RollingUpdateWorkerGroupStrategy"):
+		return &rayv1.RollingUpdateWorkerGroupStrategyApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ScaleStrategy"):
 		return &rayv1.ScaleStrategyApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ScheduledAction"):
+		return &rayv1.ScheduledActionApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SecurityOptions"):
+		return &rayv1.SecurityOptionsApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SecurityStatus"):
+		return &rayv1.SecurityStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ServeDeploymentStatus"):
 		return &rayv1.ServeDeploymentStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("SubmitterConfig"):
 		return &rayv1.SubmitterConfigApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SuspendSchedule"):
+		return &rayv1.SuspendScheduleApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("VolumeClaimRetentionPolicy"):
+		return &rayv1.VolumeClaimRetentionPolicyApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("WorkerGroupSpec"):
 		return &rayv1.WorkerGroupSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("WorkerGroupStatus"):
+		return &rayv1.WorkerGroupStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("WorkerGroupUpdateStrategy"):
+		return &rayv1.WorkerGroupUpdateStrategyApplyConfiguration{}
 
 	}
 	return nil
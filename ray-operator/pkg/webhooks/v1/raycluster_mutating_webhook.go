@@ -6,6 +6,7 @@ import (
 	routev1 "github.com/openshift/api/route/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
@@ -41,6 +42,14 @@ func (d *RayClusterDefaulter) Default(_ context.Context, obj runtime.Object) err
 		rayCluster.Annotations[utils.EnableSecureTrustedNetworkAnnotationKey] = "false"
 	}
 
+	// Toggles of the security options that are not set follow the secure network annotation. Setting them
+	// explicitly makes the effective configuration visible in the spec.
+	if options := rayCluster.Spec.SecurityOptions; options != nil {
+		options.MTLS = ptr.To(utils.IsMTLSEnabled(rayCluster))
+		options.NetworkPolicy = ptr.To(utils.IsNetworkPolicyEnabled(rayCluster))
+		options.AuthProxy = ptr.To(utils.IsAuthProxyEnabled(rayCluster))
+	}
+
 	return nil
 }
 